@@ -110,6 +110,21 @@ func init() {
 				Ui: ui,
 			}, nil
 		},
+		"maintenance": func() (cli.Command, error) {
+			return &pulsectl.MaintenanceCommand{
+				Ui: ui,
+			}, nil
+		},
+		"node": func() (cli.Command, error) {
+			return &pulsectl.NodeCommand{
+				Ui: ui,
+			}, nil
+		},
+		"tasks": func() (cli.Command, error) {
+			return &pulsectl.TasksCommand{
+				Ui: ui,
+			}, nil
+		},
 		"promote": func() (cli.Command, error) {
 			return &pulsectl.PromoteCommand{
 				Ui: ui,
@@ -17,6 +17,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	log "github.com/sirupsen/logrus"
 	"github.com/syleron/pulseha/packages/config"
@@ -100,6 +101,15 @@ func init() {
  * Essential Construct
  */
 func main() {
+	// Apply any flags or environment overrides
+	opts, err := parseOptions(os.Args[1:], os.Getenv)
+	if err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(2)
+	}
+	opts.apply()
 	// Draw logo
 	fmt.Printf(`
    ___       _                  _
@@ -118,8 +128,18 @@ func main() {
 	go handleSignals()
 	// load the config
 	pulse.DB = pulseha.Database{
-		Plugins:    &pulseha.Plugins{},
-		MemberList: &pulseha.MemberList{},
+		Plugins:      &pulseha.Plugins{},
+		MemberList:   &pulseha.MemberList{},
+		Election:     &pulseha.Election{},
+		Supervisor:   &pulseha.Supervisor{},
+		StateMachine: &pulseha.StateMachine{},
+		Events:       &pulseha.EventLog{},
+		Placement:    &pulseha.Placement{},
+		SplitBrain:   &pulseha.SplitBrain{},
+		Detector:     &pulseha.Detector{},
+		Mesh:         &pulseha.Mesh{},
+		Transport:    &pulseha.HeartbeatTransport{},
+		Replication:  &pulseha.Replication{},
 	}
 	// Setup a new pulse Logger
 	pulseLogger, err := logging.NewLogger(pulse.DB.MemberList.Broadcast)
@@ -128,10 +148,13 @@ func main() {
 	}
 	// Set our pulse logger
 	pulse.DB.Logging = pulseLogger
+	// Register the config schemas of our plugins so their sections are checked when the config is loaded
+	// Note: Plugins that can't be opened are reported when they are set up
+	config.LoadPluginSchemas(pulseha.PluginDir)
 	// Load the config
 	pulse.DB.Config = config.New()
 	// Set the logging level
-	setLogLevel(pulse.DB.Config.Pulse.LoggingLevel)
+	setLogLevel(opts.loggingLevel(pulse.DB.Config))
 	// Set log to file
 	if pulse.DB.Config.Pulse.LogToFile {
 		f, err := os.OpenFile(pulse.DB.Config.Pulse.LogFileLocation, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
@@ -0,0 +1,92 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/packages/security"
+	"github.com/syleron/pulseha/src/pulseha"
+	"net"
+	"strings"
+)
+
+// options defines the daemon settings that can be set with flags or PULSEHA_* environment variables.
+// Flags take precedence over environment variables which take precedence over the config file.
+type options struct {
+	configFile string
+	certDir    string
+	pluginDir  string
+	cliAddress string
+	// Empty uses the logging_level in the config file
+	logLevel string
+}
+
+// parseOptions parses our flags using the environment for their defaults.
+// Note: Errors are reported along with our usage the same way the flag package reports them.
+func parseOptions(args []string, getenv func(string) string) (*options, error) {
+	env := func(key string, value string) string {
+		if v := getenv(key); v != "" {
+			return v
+		}
+		return value
+	}
+	o := &options{}
+	flags := flag.NewFlagSet("pulseha", flag.ContinueOnError)
+	flags.StringVar(&o.configFile, "config", env("PULSEHA_CONFIG", config.CONFIG_LOCATION), "Config file location (PULSEHA_CONFIG)")
+	flags.StringVar(&o.certDir, "cert-dir", env("PULSEHA_CERT_DIR", security.CertDir), "TLS certificate folder (PULSEHA_CERT_DIR)")
+	flags.StringVar(&o.pluginDir, "plugin-dir", env("PULSEHA_PLUGIN_DIR", pulseha.PluginDir), "Plugin folder (PULSEHA_PLUGIN_DIR)")
+	flags.StringVar(&o.cliAddress, "cli-address", env("PULSEHA_CLI_ADDRESS", pulseha.CLIAddress), "CLI server listen address (PULSEHA_CLI_ADDRESS)")
+	flags.StringVar(&o.logLevel, "log-level", env("PULSEHA_LOG_LEVEL", ""), "Logging level overriding the config file (PULSEHA_LOG_LEVEL)")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	var err error
+	if o.logLevel != "" {
+		if _, parseErr := log.ParseLevel(o.logLevel); parseErr != nil {
+			err = errors.New("invalid log level " + o.logLevel)
+		}
+	}
+	if _, _, splitErr := net.SplitHostPort(o.cliAddress); splitErr != nil {
+		err = errors.New("the CLI address must be in the form ip:port")
+	}
+	if err != nil {
+		fmt.Fprintln(flags.Output(), err.Error())
+		flags.Usage()
+		return nil, err
+	}
+	return o, nil
+}
+
+// apply sets our locations before PulseHA starts.
+func (o *options) apply() {
+	config.CONFIG_LOCATION = o.configFile
+	security.CertDir = strings.TrimSuffix(o.certDir, "/") + "/"
+	pulseha.PluginDir = o.pluginDir
+	pulseha.CLIAddress = o.cliAddress
+}
+
+// loggingLevel returns the logging level to use.
+func (o *options) loggingLevel(c *config.Config) string {
+	if o.logLevel != "" {
+		return o.logLevel
+	}
+	return c.Pulse.LoggingLevel
+}
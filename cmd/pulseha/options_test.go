@@ -0,0 +1,82 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/packages/security"
+	"github.com/syleron/pulseha/src/pulseha"
+	"testing"
+)
+
+func TestParseOptionsPrecedence(t *testing.T) {
+	env := map[string]string{
+		"PULSEHA_CONFIG":      "/tmp/node2/config.yaml",
+		"PULSEHA_CLI_ADDRESS": "127.0.0.1:49153",
+		"PULSEHA_LOG_LEVEL":   "warn",
+	}
+	o, err := parseOptions([]string{"-log-level", "debug", "-cert-dir", "/tmp/node2/certs"}, func(key string) string {
+		return env[key]
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.configFile != "/tmp/node2/config.yaml" || o.cliAddress != "127.0.0.1:49153" {
+		t.Errorf("expected the environment to override our defaults, got %+v", o)
+	}
+	if o.logLevel != "debug" || o.certDir != "/tmp/node2/certs" {
+		t.Errorf("expected our flags to override the environment, got %+v", o)
+	}
+	if o.pluginDir != pulseha.PluginDir {
+		t.Errorf("expected the default plugin folder, got %s", o.pluginDir)
+	}
+	if level := o.loggingLevel(&config.Config{Pulse: config.Local{LoggingLevel: "info"}}); level != "debug" {
+		t.Errorf("expected the log level override to take precedence over the config file, got %s", level)
+	}
+}
+
+func TestParseOptionsApply(t *testing.T) {
+	location, certDir, pluginDir, cliAddress := config.CONFIG_LOCATION, security.CertDir, pulseha.PluginDir, pulseha.CLIAddress
+	defer func() {
+		config.CONFIG_LOCATION, security.CertDir, pulseha.PluginDir, pulseha.CLIAddress = location, certDir, pluginDir, cliAddress
+	}()
+	o, err := parseOptions([]string{"-cert-dir", "/tmp/certs", "-plugin-dir", "/tmp/plugins"}, func(string) string {
+		return ""
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.apply()
+	if security.CertDir != "/tmp/certs/" || pulseha.PluginDir != "/tmp/plugins" {
+		t.Errorf("expected our locations to be applied, got %s %s", security.CertDir, pulseha.PluginDir)
+	}
+	if o.loggingLevel(&config.Config{Pulse: config.Local{LoggingLevel: "info"}}) != "info" {
+		t.Error("expected the config file log level without an override")
+	}
+}
+
+func TestParseOptionsInvalid(t *testing.T) {
+	noEnv := func(string) string {
+		return ""
+	}
+	if _, err := parseOptions([]string{"-log-level", "loud"}, noEnv); err == nil {
+		t.Error("expected an invalid log level to be rejected")
+	}
+	if _, err := parseOptions([]string{"-cli-address", "localhost"}, noEnv); err == nil {
+		t.Error("expected a CLI address without a port to be rejected")
+	}
+}
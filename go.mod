@@ -13,6 +13,7 @@ require (
 	golang.org/x/sys v0.19.0
 	google.golang.org/grpc v1.56.3
 	google.golang.org/protobuf v1.33.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -18,23 +18,25 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/syleron/pulseha/packages/security"
 	"github.com/syleron/pulseha/rpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"io/ioutil"
 	"time"
 )
 
 type Client struct {
 	Connection *grpc.ClientConn
 	Requester  rpc.ServerClient
+	// The hostname the server's certificate must be issued to
+	ServerName string
 }
 
+// DefaultTimeout is how long we wait for an RPC command to complete.
+const DefaultTimeout = 5 * time.Second
+
 // TODO: This should probably go into an enums folder
 type ProtoFunction int
 
@@ -49,6 +51,15 @@ const (
 	SendPromote
 	SendLogs
 	SendRemove
+	SendVote
+	SendPreflight
+	SendGroupStatus
+	SendHeartbeat
+	SendDescribe
+	SendConfigVersion
+	SendSignCertificate
+	SendCertificateStatus
+	SendCertUpdate
 )
 
 var protoFunctions = []string{
@@ -62,6 +73,15 @@ var protoFunctions = []string{
 	"Promote",
 	"Logs",
 	"Remove",
+	"Vote",
+	"Preflight",
+	"GroupStatus",
+	"Heartbeat",
+	"Describe",
+	"ConfigVersion",
+	"SignCertificate",
+	"CertificateStatus",
+	"CertUpdate",
 }
 
 func (p ProtoFunction) String() string {
@@ -104,6 +124,33 @@ func (c *Client) GetProtoFuncList() map[string]interface{} {
 		"Remove": func(ctx context.Context, data interface{}) (interface{}, error) {
 			return c.Requester.Remove(ctx, data.(*rpc.RemoveRequest))
 		},
+		"Vote": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.Vote(ctx, data.(*rpc.VoteRequest))
+		},
+		"Preflight": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.Preflight(ctx, data.(*rpc.PreflightRequest))
+		},
+		"GroupStatus": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.GroupStatus(ctx, data.(*rpc.GroupStatusRequest))
+		},
+		"Heartbeat": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.Heartbeat(ctx, data.(*rpc.HeartbeatRequest))
+		},
+		"Describe": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.Describe(ctx, data.(*rpc.DescribeRequest))
+		},
+		"ConfigVersion": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.ConfigVersion(ctx, data.(*rpc.ConfigVersionRequest))
+		},
+		"SignCertificate": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.SignCertificate(ctx, data.(*rpc.SignCertificateRequest))
+		},
+		"CertificateStatus": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.CertificateStatus(ctx, data.(*rpc.CertificateStatusRequest))
+		},
+		"CertUpdate": func(ctx context.Context, data interface{}) (interface{}, error) {
+			return c.Requester.CertUpdate(ctx, data.(*rpc.CertUpdateRequest))
+		},
 	}
 	return funcList
 }
@@ -112,34 +159,22 @@ func (c *Client) GetProtoFuncList() map[string]interface{} {
 func (c *Client) Connect(ip string, port string, tlsEnabled bool) error {
 	var err error
 	if tlsEnabled {
-		// Load member cert/key
-		peerCert, err := tls.LoadX509KeyPair(
-			security.CertDir+"pulseha.crt",
-			security.CertDir+"pulseha.key",
-		)
+		if c.ServerName == "" {
+			return errors.New("Could not connect to host: the hostname of the member is required to verify its certificate")
+		}
+		// Verify the member's certificate and present our own
+		config, err := security.ClientTLSConfig(c.ServerName)
 		if err != nil {
 			return errors.New("Could not connect to host: " + err.Error())
 		}
-		// Load CA
-		caCert, err := ioutil.ReadFile(security.CertDir + "ca.crt")
+		c.Connection, err = grpc.Dial(ip+":"+port, grpc.WithTransportCredentials(credentials.NewTLS(config)))
 		if err != nil {
+			log.Errorf("GRPC client connection error: %s", err.Error())
 			return errors.New("Could not connect to host: " + err.Error())
 		}
-		caCertPool := x509.NewCertPool()
-		if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
-			return errors.New("failed to append ca certs")
-		}
-		creds := credentials.NewTLS(&tls.Config{
-			InsecureSkipVerify: true,
-			Certificates:       []tls.Certificate{peerCert},
-			RootCAs:            caCertPool,
-		})
-		c.Connection, err = grpc.Dial(ip+":"+port, grpc.WithTransportCredentials(creds))
 	} else {
-		config := &tls.Config{
-			InsecureSkipVerify: true,
-		}
-		c.Connection, err = grpc.Dial(ip+":"+port, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+		// Used to join a cluster before we have the cluster CA
+		c.Connection, err = grpc.Dial(ip+":"+port, grpc.WithTransportCredentials(credentials.NewTLS(security.JoinTLSConfig())))
 	}
 	if err != nil {
 		log.Errorf("GRPC client connection error: %s", err.Error())
@@ -161,9 +196,14 @@ func (c *Client) Close() {
 
 // Send sends an RPC command over the client connection.
 func (c *Client) Send(funcName ProtoFunction, data interface{}) (interface{}, error) {
+	return c.SendTimeout(funcName, data, DefaultTimeout)
+}
+
+// SendTimeout sends an RPC command over the client connection and gives up after the timeout.
+func (c *Client) SendTimeout(funcName ProtoFunction, data interface{}, timeout time.Duration) (interface{}, error) {
 	log.Debug("Client:Send() Sending " + funcName.String())
 	funcList := c.GetProtoFuncList()
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	return funcList[funcName.String()].(func(context.Context, interface{}) (interface{}, error))(
 		ctx, data,
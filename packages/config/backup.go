@@ -0,0 +1,146 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"github.com/syleron/pulseha/packages/utils"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// backupTimeFormat is the sortable UTC timestamp used to name our config backups.
+const backupTimeFormat = "20060102T150405.000000000Z"
+
+// Backup defines a previous copy of our config file.
+type Backup struct {
+	// The backup number used to roll back. 1 is the newest
+	Index int
+	// The path to the backup file
+	File string
+	// When the backup was taken
+	Time time.Time
+	// The cluster config version of the backup
+	Version Version
+}
+
+// BackupDir returns the folder our config backups are kept in.
+func BackupDir() string {
+	return filepath.Join(filepath.Dir(CONFIG_LOCATION), "backups")
+}
+
+// backup copies the config file we are about to replace into our backups folder.
+// Nothing is backed up when the config file doesn't exist or is unchanged.
+// Note: The caller must hold the config lock.
+func (c *Config) backup(file string, configData []byte) error {
+	current, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if bytes.Equal(current, configData) {
+		return nil
+	}
+	if err := os.MkdirAll(BackupDir(), 0755); err != nil {
+		return err
+	}
+	name := "config-" + time.Now().UTC().Format(backupTimeFormat) + filepath.Ext(file)
+	if err := utils.WriteFileAtomic(filepath.Join(BackupDir(), name), current, 0600); err != nil {
+		return err
+	}
+	return pruneBackups(c.GetConfigBackups())
+}
+
+// pruneBackups removes all but the newest backups.
+func pruneBackups(keep int) error {
+	backups, err := Backups()
+	if err != nil {
+		return err
+	}
+	for _, backup := range backups {
+		if backup.Index <= keep {
+			continue
+		}
+		if err := os.Remove(backup.File); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Backups returns our config backups from newest to oldest.
+func Backups() ([]Backup, error) {
+	files, err := ioutil.ReadDir(BackupDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Backup{}, nil
+		}
+		return nil, err
+	}
+	backups := []Backup{}
+	for _, file := range files {
+		name := file.Name()
+		ext := filepath.Ext(name)
+		if file.IsDir() || !strings.HasPrefix(name, "config-") || (ext != ".json" && ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		tym, err := time.Parse(backupTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, "config-"), ext))
+		if err != nil {
+			continue
+		}
+		backup := Backup{
+			File: filepath.Join(BackupDir(), name),
+			Time: tym,
+		}
+		// The version is informational so a backup we can't read is still listed
+		if backupConfig, err := ReadFile(backup.File); err == nil {
+			backup.Version = backupConfig.Version
+		}
+		backups = append(backups, backup)
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Time.After(backups[j].Time)
+	})
+	for i := range backups {
+		backups[i].Index = i + 1
+	}
+	return backups, nil
+}
+
+// LoadBackup reads a config backup by its backup number.
+func LoadBackup(index int) (*Config, error) {
+	backups, err := Backups()
+	if err != nil {
+		return nil, err
+	}
+	if index < 1 || index > len(backups) {
+		return nil, errors.New("config backup " + strconv.Itoa(index) + " does not exist")
+	}
+	backup, err := ReadFile(backups[index-1].File)
+	if err != nil {
+		return nil, errors.New("unable to read config backup " + strconv.Itoa(index) + ": " + err.Error())
+	}
+	return backup, nil
+}
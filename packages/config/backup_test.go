@@ -0,0 +1,94 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// setupTestConfigLocation points our config file at a temporary folder.
+func setupTestConfigLocation(t *testing.T) {
+	location := CONFIG_LOCATION
+	CONFIG_LOCATION = filepath.Join(t.TempDir(), "config.json")
+	t.Cleanup(func() {
+		CONFIG_LOCATION = location
+	})
+}
+
+func TestSaveKeepsBackups(t *testing.T) {
+	setupTestConfigLocation(t)
+	c := &Config{
+		Pulse: Local{
+			HealthCheckInterval: 1000,
+			FailOverInterval:    5000,
+			FailOverLimit:       10000,
+			ConfigBackups:       2,
+		},
+		Groups: map[string][]string{},
+		Nodes:  map[string]*Node{},
+	}
+	for i := uint64(1); i <= 4; i++ {
+		c.Version = Version{Number: i, Origin: "node1"}
+		if err := c.Save(); err != nil {
+			t.Fatal(err)
+		}
+		// Make sure each backup has a distinct timestamp
+		time.Sleep(time.Millisecond)
+	}
+	// Saving an unchanged config doesn't take a backup
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+	backups, err := Backups()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("expected 2 backups, got %d", len(backups))
+	}
+	if backups[0].Index != 1 || backups[0].Version.Number != 3 || backups[1].Version.Number != 2 {
+		t.Errorf("expected the newest backups first, got %+v", backups)
+	}
+	backup, err := LoadBackup(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backup.Version.Number != 2 {
+		t.Errorf("expected backup 2 to have version 2, got %s", backup.Version)
+	}
+	if _, err := LoadBackup(3); err == nil {
+		t.Error("expected an error loading a backup that doesn't exist")
+	}
+}
+
+func TestBackupsIgnoresOtherFiles(t *testing.T) {
+	setupTestConfigLocation(t)
+	if err := os.MkdirAll(BackupDir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(BackupDir(), "notes.txt"), []byte("notes"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	backups, err := Backups()
+	if err != nil || len(backups) != 0 {
+		t.Errorf("expected no backups, got %v %v", backups, err)
+	}
+}
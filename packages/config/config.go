@@ -17,39 +17,150 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/syleron/pulseha/packages/jsonHelper"
+	"github.com/syleron/pulseha/packages/security"
 	"github.com/syleron/pulseha/packages/utils"
-	"io/ioutil"
+	"net"
 	"os"
+	"path/filepath"
 	"runtime"
+	"strconv"
 	"sync"
+	"time"
 )
 
 var (
 	CONFIG_LOCATION = "/etc/pulseha/config.json"
 )
 
+// DefaultPluginDir is the folder plugins are loaded from unless another is configured.
+const DefaultPluginDir = "/usr/local/lib/pulseha/"
+
+// DefaultCLIAddress is the address the CLI server listens on unless another is configured.
+const DefaultCLIAddress = "127.0.0.1:49152"
+
+const (
+	// Abort the failover when the failed node could not be fenced
+	FencePolicyAbort = "abort"
+	// Continue with the failover when the failed node could not be fenced
+	FencePolicyContinue = "continue"
+	// The default time in milliseconds we wait for fencing to complete
+	DefaultFenceTimeout = 30000
+	// Fail over when no health check has been received for the failover limit
+	DetectorFixed = "fixed"
+	// Fail over based on the phi accrual of the health check arrival times
+	DetectorPhiAccrual = "phi_accrual"
+	// The default phi at which the active is considered suspicious
+	DefaultPhiSuspectThreshold = 5.0
+	// The default phi at which we fail over
+	DefaultPhiFailoverThreshold = 8.0
+	// The default number of health check arrival times used to calculate phi
+	DefaultPhiWindowSize = 100
+	// The smallest health check interval, failover interval and failover limit in milliseconds
+	MinInterval = 100
+	// Send health checks as gRPC calls
+	TransportGRPC = "grpc"
+	// Send health checks as signed UDP datagrams
+	TransportUDP = "udp"
+	// The default number of previous config files kept
+	DefaultConfigBackups = 10
+)
+
 type Config struct {
 	Pulse   Local                  `json:"pulseha"`
 	Groups  map[string][]string    `json:"floating_ip_groups"`
 	Nodes   map[string]*Node       `json:"nodes"`
 	Plugins map[string]interface{} `json:"plugins"`
+	// The node each floating IP group is placed on.
+	// Groups without a placement are held by the active node.
+	GroupOwners map[string]string `json:"group_owners,omitempty"`
+	// The version of the replicated cluster config
+	Version Version `json:"version"`
+	// The key every member signs UDP health checks with
+	HeartbeatKey string `json:"heartbeat_key,omitempty"`
+	// The unknown fields found when the config was parsed
+	warnings []string
 	sync.Mutex
 }
 
+// Version identifies a change to the replicated cluster config.
+// Note: The pulseha and plugins sections are local to each node and are not versioned.
+type Version struct {
+	// Incremented every time the cluster config is changed
+	Number uint64 `json:"number"`
+	// The hostname of the node that made the change
+	Origin string `json:"origin"`
+}
+
+// Supersedes determines whether a version replaces another version.
+// Concurrent changes with the same number are resolved in favour of the lowest hostname
+// so every node makes the same choice.
+func (v Version) Supersedes(o Version) bool {
+	if v.Number != o.Number {
+		return v.Number > o.Number
+	}
+	if v.Origin == o.Origin || v.Origin == "" {
+		return false
+	}
+	return o.Origin == "" || v.Origin < o.Origin
+}
+
+// String returns the version in the form number (origin).
+func (v Version) String() string {
+	if v.Origin == "" {
+		return strconv.FormatUint(v.Number, 10)
+	}
+	return strconv.FormatUint(v.Number, 10) + " (" + v.Origin + ")"
+}
+
 type Local struct {
-	HealthCheckInterval int    `json:"hcs_interval"`
-	FailOverInterval    int    `json:"fos_interval"`
-	FailOverLimit       int    `json:"fo_limit"`
+	HealthCheckInterval int    `json:"hcs_interval" schema:"min=100"`
+	FailOverInterval    int    `json:"fos_interval" schema:"min=100"`
+	FailOverLimit       int    `json:"fo_limit" schema:"min=100"`
 	LocalNode           string `json:"local_node"`
 	ClusterToken        string `json:"cluster_token"`
 	LoggingLevel        string `json:"logging_level"`
 	AutoFailback        bool   `json:"auto_failback"`
 	LogToFile           bool   `json:"log_to_file"`
 	LogFileLocation     string `json:"log_file_location"`
+	FenceTimeout        int    `json:"fence_timeout" schema:"min=0"`
+	FencePolicy         string `json:"fence_policy" schema:"enum=abort|continue"`
+	// The failure detector used to decide whether the active has failed
+	FailureDetector string `json:"failure_detector" schema:"enum=fixed|phi_accrual"`
+	// Time in milliseconds without a health check before the active is suspicious (fixed detector)
+	SuspectTimeout int `json:"suspect_timeout" schema:"min=0"`
+	// The phi thresholds for suspicion and failover (phi accrual detector)
+	PhiSuspectThreshold  float64 `json:"phi_suspect_threshold" schema:"min=0"`
+	PhiFailoverThreshold float64 `json:"phi_failover_threshold" schema:"min=0"`
+	// The number of health check arrival times kept (phi accrual detector)
+	PhiWindowSize int `json:"phi_window_size" schema:"min=0"`
+	// Every member heartbeats every other member to build a reachability matrix
+	MeshHealthChecks bool `json:"mesh_health_checks"`
+	// How health checks are sent to each member
+	HeartbeatTransport string `json:"heartbeat_transport" schema:"enum=grpc|udp"`
+	// The UDP port health checks are sent to (Default: each node's bind port)
+	HeartbeatPort string `json:"heartbeat_port"`
+	// The ip:port multicast group health checks are sent to instead of each member
+	HeartbeatMulticastGroup string `json:"heartbeat_multicast_group"`
+	// The number of previous config files kept (Default: 10)
+	ConfigBackups int `json:"config_backups" schema:"min=0"`
+	// The algorithm of the keys we generate (Default: rsa-2048)
+	KeyAlgorithm string `json:"key_algorithm" schema:"enum=rsa-2048|rsa-3072|rsa-4096|ecdsa-p256|ecdsa-p384|ed25519"`
+	// The minimum TLS version our server and client accept (Default: 1.2)
+	TLSMinVersion string `json:"tls_min_version" schema:"enum=1.2|1.3"`
+	// The TLS 1.2 cipher suites our server and client accept (Default: Go's secure cipher suites)
+	TLSCipherSuites []string `json:"tls_cipher_suites,omitempty"`
+	// Our certificates are issued by an external CA so PulseHA never generates or distributes keys
+	TLSExternalCA bool `json:"tls_external_ca"`
+	// Our certificate, key and the CA bundle our members are verified against (external CA only)
+	TLSCertFile string `json:"tls_cert_file,omitempty"`
+	TLSKeyFile  string `json:"tls_key_file,omitempty"`
+	TLSCAFile   string `json:"tls_ca_file,omitempty"`
 }
 
 type Node struct {
@@ -57,6 +168,41 @@ type Node struct {
 	IP       string              `json:"bind_address"`
 	Port     string              `json:"bind_port"`
 	IPGroups map[string][]string `json:"group_assignments"`
+	// Monitoring nodes take part in the cluster but never become active
+	Monitoring bool `json:"monitoring"`
+	// Nodes with a higher priority are chosen first to become active
+	Priority int `json:"priority"`
+	// The preferred node becomes active whenever it is available
+	Preferred bool `json:"preferred"`
+	// Nodes in maintenance are drained and excluded from election
+	Maintenance bool     `json:"maintenance"`
+	Fencing     *Fencing `json:"fencing,omitempty"`
+	// Additional ip:port addresses health checks can be sent over (e.g. a crossover link)
+	HeartbeatAddresses []string `json:"heartbeat_addresses,omitempty"`
+}
+
+// HeartbeatPaths returns every address health checks can be sent over.
+// Note: The bind address is always the first path.
+func (n *Node) HeartbeatPaths() []string {
+	return append([]string{net.JoinHostPort(n.IP, n.Port)}, n.HeartbeatAddresses...)
+}
+
+// Electable returns whether the node is able to become active.
+func (n *Node) Electable() bool {
+	return !n.Monitoring && !n.Maintenance
+}
+
+// Fencing defines how a node is isolated before another node takes over from it.
+// Driver is either a built in driver (exec, redfish) or the name of a fencing plugin.
+type Fencing struct {
+	Driver   string   `json:"driver"`
+	Command  string   `json:"command,omitempty"`
+	Args     []string `json:"args,omitempty"`
+	Address  string   `json:"address,omitempty"`
+	System   string   `json:"system,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	Insecure bool     `json:"insecure,omitempty"`
 }
 
 // New instantiates and setups up our config object
@@ -68,6 +214,66 @@ func New() *Config {
 	return &cfg
 }
 
+// NextVersion increments the version of the cluster config for a change made by a node.
+func (c *Config) NextVersion(origin string) Version {
+	c.Lock()
+	defer c.Unlock()
+	c.Version = Version{
+		Number: c.Version.Number + 1,
+		Origin: origin,
+	}
+	return c.Version
+}
+
+// Hash returns a hash of the replicated cluster config.
+// Note: The pulseha and plugins sections are local to each node and are not included.
+func (c *Config) Hash() string {
+	c.Lock()
+	defer c.Unlock()
+	// Empty sections hash the same whether or not they survived a sync
+	replicated := struct {
+		Groups       map[string][]string
+		Nodes        map[string]*Node
+		GroupOwners  map[string]string
+		Version      Version
+		HeartbeatKey string `json:",omitempty"`
+	}{Version: c.Version, HeartbeatKey: c.HeartbeatKey}
+	if len(c.Groups) > 0 {
+		replicated.Groups = c.Groups
+	}
+	if len(c.Nodes) > 0 {
+		replicated.Nodes = c.Nodes
+	}
+	if len(c.GroupOwners) > 0 {
+		replicated.GroupOwners = c.GroupOwners
+	}
+	buf, err := json.Marshal(replicated)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:])
+}
+
+// Warnings returns the unknown fields found when the config was parsed.
+func (c *Config) Warnings() []string {
+	return c.warnings
+}
+
+// MarshalReplicated returns the replicated cluster config as JSON.
+// Note: Our pulseha and plugins sections are local to this node and are left out.
+func (c *Config) MarshalReplicated() ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	return json.Marshal(&Config{
+		Groups:       c.Groups,
+		Nodes:        c.Nodes,
+		GroupOwners:  c.GroupOwners,
+		Version:      c.Version,
+		HeartbeatKey: c.HeartbeatKey,
+	})
+}
+
 // GetConfig - Returns a copy of the config
 func (c *Config) GetConfig() Config {
 	return *c
@@ -116,15 +322,21 @@ func (c *Config) Load() error {
 	c.Lock()
 	defer c.Unlock()
 	// Check to see if we have a config already
-	if utils.CheckFileExists(CONFIG_LOCATION) {
-		b, err := ioutil.ReadFile(CONFIG_LOCATION)
+	if file := File(); utils.CheckFileExists(file) {
+		loaded, err := ReadFile(file)
 		if err != nil {
-			log.Fatalf("Error reading config file: %s", err)
+			log.Fatalf("Unable to load config %s: %s", file, err)
 			return err
 		}
-		if err = json.Unmarshal([]byte(b), &c); err != nil {
-			log.Fatalf("Unable to unmarshal config: %s", err)
-			return err
+		c.Pulse = loaded.Pulse
+		c.Groups = loaded.Groups
+		c.Nodes = loaded.Nodes
+		c.Plugins = loaded.Plugins
+		c.GroupOwners = loaded.GroupOwners
+		c.Version = loaded.Version
+		c.HeartbeatKey = loaded.HeartbeatKey
+		for _, warning := range loaded.Warnings() {
+			log.Warnf("Config %s: %s. The value is ignored.", file, warning)
 		}
 		if err := c.Validate(); err != nil {
 			log.Fatalf(err.Error())
@@ -137,6 +349,18 @@ func (c *Config) Load() error {
 			os.Exit(1)
 		}
 	}
+	// Use our key algorithm and TLS parameters from now on
+	policy, err := c.GetSecurityPolicy()
+	if err != nil {
+		return err
+	}
+	security.SetPolicy(policy)
+	// Use the certificates issued by an external CA when configured
+	externalCA, err := c.GetExternalCA()
+	if err != nil {
+		return err
+	}
+	security.SetExternalCA(externalCA)
 	return nil
 }
 
@@ -151,16 +375,21 @@ func (c *Config) Save() error {
 	if err := c.Validate(); err != nil {
 		return errors.New(err.Error())
 	}
-	// Convert struct back to JSON format
-	configJSON, err := json.MarshalIndent(c, "", "    ")
+	// Convert struct back to the format of our config file
+	file := File()
+	configData, err := c.encode(FormatOf(file))
 	if err != nil {
 		return err
 	}
+	// Keep a copy of the config we are replacing
+	if err := c.backup(file, configData); err != nil {
+		log.Warn("Unable to back up " + file + ": " + err.Error())
+	}
 	// Save back to file
-	err = ioutil.WriteFile(CONFIG_LOCATION, configJSON, 0644)
+	err = utils.WriteFileAtomic(file, configData, 0644)
 	// Check for errors
 	if err != nil {
-		log.Error("Unable to save config.json. Either it doesn't exist or there may be a permissions issue")
+		log.Error("Unable to save " + file + ". Either it doesn't exist or there may be a permissions issue")
 		return err
 	}
 	return nil
@@ -181,21 +410,15 @@ func (c *Config) Reload() {
  *
  */
 func (c *Config) Validate() error {
+	if err := c.ValidateSettings(); err != nil {
+		return err
+	}
+
 	hostname, err := utils.GetHostname()
 	if err != nil {
 		return errors.New("unable to get local hostname")
 	}
 
-	// Make sure our groups section is valid
-	if c.Groups == nil {
-		return errors.New("unable to load groups section of the config")
-	}
-
-	// Make sure our nodes section is valid
-	if c.Nodes == nil {
-		return errors.New("unable to load nodes section of the config")
-	}
-
 	// if we are in a cluster. does our hostname exist?
 	if c.ClusterCheck() {
 		var exists = func() bool {
@@ -211,17 +434,216 @@ func (c *Config) Validate() error {
 		}
 	}
 
-	if c.Pulse.FailOverInterval < 1000 || c.Pulse.FailOverLimit < 1000 || c.Pulse.HealthCheckInterval < 1000 {
-		return errors.New("please make sure the interval and limit values in your config are valid millisecond values of at least one second")
+	return nil
+}
+
+// ValidateSettings checks the config values without checking the config belongs to the local node.
+func (c *Config) ValidateSettings() error {
+	// Make sure our groups section is valid
+	if c.Groups == nil {
+		return errors.New("unable to load groups section of the config")
+	}
+
+	// Make sure our nodes section is valid
+	if c.Nodes == nil {
+		return errors.New("unable to load nodes section of the config")
+	}
+
+	if c.Pulse.FailOverInterval < MinInterval || c.Pulse.FailOverLimit < MinInterval || c.Pulse.HealthCheckInterval < MinInterval {
+		return errors.New("please make sure the interval and limit values in your config are valid millisecond values of at least " + strconv.Itoa(MinInterval) + "ms")
 	}
 
 	if c.Pulse.FailOverLimit < c.Pulse.FailOverInterval {
 		return errors.New("the fos_interval value must be a smaller value then your fo_limit")
 	}
 
+	if c.Pulse.FailOverLimit <= c.Pulse.HealthCheckInterval {
+		return errors.New("the hcs_interval value must be a smaller value then your fo_limit")
+	}
+
+	if c.Pulse.FenceTimeout < 0 {
+		return errors.New("the fence_timeout value must be a positive millisecond value")
+	}
+
+	if c.Pulse.FencePolicy != "" && c.Pulse.FencePolicy != FencePolicyAbort && c.Pulse.FencePolicy != FencePolicyContinue {
+		return errors.New("the fence_policy value must be either " + FencePolicyAbort + " or " + FencePolicyContinue)
+	}
+
+	if c.Pulse.FailureDetector != "" && c.Pulse.FailureDetector != DetectorFixed && c.Pulse.FailureDetector != DetectorPhiAccrual {
+		return errors.New("the failure_detector value must be either " + DetectorFixed + " or " + DetectorPhiAccrual)
+	}
+
+	if c.Pulse.SuspectTimeout < 0 || c.Pulse.SuspectTimeout > c.Pulse.FailOverLimit {
+		return errors.New("the suspect_timeout value must be a positive millisecond value no larger than your fo_limit")
+	}
+
+	if c.Pulse.PhiSuspectThreshold < 0 || c.Pulse.PhiFailoverThreshold < 0 || c.Pulse.PhiWindowSize < 0 {
+		return errors.New("the phi_suspect_threshold, phi_failover_threshold and phi_window_size values must be positive")
+	}
+
+	if c.GetPhiSuspectThreshold() > c.GetPhiFailoverThreshold() {
+		return errors.New("the phi_suspect_threshold value must be smaller than your phi_failover_threshold")
+	}
+
+	if c.Pulse.HeartbeatTransport != "" && c.Pulse.HeartbeatTransport != TransportGRPC && c.Pulse.HeartbeatTransport != TransportUDP {
+		return errors.New("the heartbeat_transport value must be either " + TransportGRPC + " or " + TransportUDP)
+	}
+
+	if c.Pulse.HeartbeatPort != "" {
+		if port, err := strconv.Atoi(c.Pulse.HeartbeatPort); err != nil || port < 1 || port > 65535 {
+			return errors.New("the heartbeat_port value must be a valid port number")
+		}
+	}
+
+	if c.Pulse.HeartbeatMulticastGroup != "" {
+		host, _, err := net.SplitHostPort(c.Pulse.HeartbeatMulticastGroup)
+		if ip := net.ParseIP(host); err != nil || ip == nil || !ip.IsMulticast() {
+			return errors.New("the heartbeat_multicast_group value must be a multicast ip:port address")
+		}
+	}
+
+	if c.Pulse.ConfigBackups < 0 {
+		return errors.New("the config_backups value must not be negative")
+	}
+
+	if _, err := c.GetSecurityPolicy(); err != nil {
+		return err
+	}
+
+	if _, err := c.GetExternalCA(); err != nil {
+		return err
+	}
+
+	for _, node := range c.Nodes {
+		if node.Fencing != nil && node.Fencing.Driver == "" {
+			return errors.New("fencing for node " + node.Hostname + " requires a driver")
+		}
+		for _, address := range node.HeartbeatAddresses {
+			if _, _, err := net.SplitHostPort(address); err != nil {
+				return errors.New("heartbeat address " + address + " for node " + node.Hostname + " must be in the form ip:port")
+			}
+		}
+	}
+
 	return nil
 }
 
+// GetFenceTimeout returns how long we wait for fencing to complete.
+func (c *Config) GetFenceTimeout() time.Duration {
+	if c.Pulse.FenceTimeout == 0 {
+		return time.Duration(DefaultFenceTimeout) * time.Millisecond
+	}
+	return time.Duration(c.Pulse.FenceTimeout) * time.Millisecond
+}
+
+// FenceContinueOnFailure returns whether a failover should continue when fencing fails.
+func (c *Config) FenceContinueOnFailure() bool {
+	return c.Pulse.FencePolicy == FencePolicyContinue
+}
+
+// GetFailureDetector returns the failure detector to use.
+func (c *Config) GetFailureDetector() string {
+	if c.Pulse.FailureDetector == "" {
+		return DetectorFixed
+	}
+	return c.Pulse.FailureDetector
+}
+
+// GetSuspectTimeout returns how long without a health check before the active is suspicious.
+// Note: Defaults to half of the failover limit.
+func (c *Config) GetSuspectTimeout() time.Duration {
+	if c.Pulse.SuspectTimeout == 0 {
+		return time.Duration(c.Pulse.FailOverLimit/2) * time.Millisecond
+	}
+	return time.Duration(c.Pulse.SuspectTimeout) * time.Millisecond
+}
+
+// GetPhiSuspectThreshold returns the phi at which the active is suspicious.
+func (c *Config) GetPhiSuspectThreshold() float64 {
+	if c.Pulse.PhiSuspectThreshold == 0 {
+		return DefaultPhiSuspectThreshold
+	}
+	return c.Pulse.PhiSuspectThreshold
+}
+
+// GetPhiFailoverThreshold returns the phi at which we fail over.
+func (c *Config) GetPhiFailoverThreshold() float64 {
+	if c.Pulse.PhiFailoverThreshold == 0 {
+		return DefaultPhiFailoverThreshold
+	}
+	return c.Pulse.PhiFailoverThreshold
+}
+
+// GetPhiWindowSize returns the number of health check arrival times used to calculate phi.
+func (c *Config) GetPhiWindowSize() int {
+	if c.Pulse.PhiWindowSize == 0 {
+		return DefaultPhiWindowSize
+	}
+	return c.Pulse.PhiWindowSize
+}
+
+// GetHeartbeatTransport returns how health checks are sent.
+func (c *Config) GetHeartbeatTransport() string {
+	if c.Pulse.HeartbeatTransport == "" {
+		return TransportGRPC
+	}
+	return c.Pulse.HeartbeatTransport
+}
+
+// GetHeartbeatKey returns the key every member signs UDP health checks with.
+func (c *Config) GetHeartbeatKey() []byte {
+	c.Lock()
+	defer c.Unlock()
+	return []byte(c.HeartbeatKey)
+}
+
+// SetHeartbeatKey sets the key every member signs UDP health checks with.
+func (c *Config) SetHeartbeatKey(key string) {
+	c.Lock()
+	defer c.Unlock()
+	c.HeartbeatKey = key
+}
+
+// GetHeartbeatMulticastGroup returns the ip:port multicast group health checks are sent to.
+func (c *Config) GetHeartbeatMulticastGroup() string {
+	c.Lock()
+	defer c.Unlock()
+	return c.Pulse.HeartbeatMulticastGroup
+}
+
+// GetHeartbeatPort returns the UDP port a node receives health checks on.
+func (c *Config) GetHeartbeatPort(node Node) string {
+	if c.Pulse.HeartbeatPort == "" {
+		return node.Port
+	}
+	return c.Pulse.HeartbeatPort
+}
+
+// GetConfigBackups returns the number of previous config files kept.
+func (c *Config) GetConfigBackups() int {
+	if c.Pulse.ConfigBackups == 0 {
+		return DefaultConfigBackups
+	}
+	return c.Pulse.ConfigBackups
+}
+
+// GetSecurityPolicy returns the algorithm of the keys we generate and the TLS parameters we accept.
+func (c *Config) GetSecurityPolicy() (security.Policy, error) {
+	return security.NewPolicy(c.Pulse.KeyAlgorithm, c.Pulse.TLSMinVersion, c.Pulse.TLSCipherSuites)
+}
+
+// GetExternalCA returns the certificate, key and CA bundle issued by an external CA.
+// Returns nil when PulseHA manages our certificates.
+func (c *Config) GetExternalCA() (*security.ExternalCA, error) {
+	if !c.Pulse.TLSExternalCA {
+		if c.Pulse.TLSCertFile != "" || c.Pulse.TLSKeyFile != "" || c.Pulse.TLSCAFile != "" {
+			return nil, errors.New("the tls_cert_file, tls_key_file and tls_ca_file values require tls_external_ca")
+		}
+		return nil, nil
+	}
+	return security.NewExternalCA(c.Pulse.TLSCertFile, c.Pulse.TLSKeyFile, c.Pulse.TLSCAFile)
+}
+
 // LocalNode - Get the local node object
 func (c *Config) LocalNode() Node {
 	hostname, err := utils.GetHostname()
@@ -289,6 +711,12 @@ func (c *Config) GetNodeHostnameByAddress(address string) (string, error) {
 		if node.IP == address {
 			return node.Hostname, nil
 		}
+		// Health checks may also arrive over one of our heartbeat paths
+		for _, path := range node.HeartbeatAddresses {
+			if host, _, err := net.SplitHostPort(path); err == nil && host == address {
+				return node.Hostname, nil
+			}
+		}
 	}
 	return "", errors.New("unable to find node with IP address " + address)
 }
@@ -337,14 +765,20 @@ func (c *Config) SaveDefaultLocalConfig() error {
 			ClusterToken:        "",
 			LoggingLevel:        "info",
 			LogToFile:           true,
-			LogFileLocation:     "/etc/pulseha/pulseha.log",
+			LogFileLocation:     filepath.Join(filepath.Dir(CONFIG_LOCATION), "pulseha.log"),
+			FenceTimeout:        DefaultFenceTimeout,
+			FencePolicy:         FencePolicyAbort,
+			FailureDetector:     DetectorFixed,
+			HeartbeatTransport:  TransportGRPC,
+			KeyAlgorithm:        security.DefaultKeyAlgorithm,
+			TLSMinVersion:       "1.2",
 		},
 		Groups:  map[string][]string{},
 		Nodes:   map[string]*Node{},
 		Plugins: map[string]interface{}{},
 	}
-	// Convert struct back to JSON format
-	configJSON, err := json.MarshalIndent(defaultConfig, "", "    ")
+	// Convert struct back to the format of our config file
+	configData, err := defaultConfig.encode(FormatOf(CONFIG_LOCATION))
 	if err != nil {
 		return err
 	}
@@ -354,7 +788,7 @@ func (c *Config) SaveDefaultLocalConfig() error {
 	c.Nodes = defaultConfig.Nodes
 	c.Plugins = make(map[string]interface{})
 	// Save back to file
-	err = ioutil.WriteFile(CONFIG_LOCATION, configJSON, 0644)
+	err = utils.WriteFileAtomic(CONFIG_LOCATION, configData, 0644)
 	// Check for errors
 	if err != nil {
 		log.Error("Unable to save config.json. There may be a permissions issue")
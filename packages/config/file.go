@@ -0,0 +1,112 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/syleron/pulseha/packages/utils"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// File returns the config file in use.
+// A config.yaml or config.yml file next to the JSON config file is used when the JSON file doesn't exist.
+func File() string {
+	if utils.CheckFileExists(CONFIG_LOCATION) {
+		return CONFIG_LOCATION
+	}
+	base := strings.TrimSuffix(CONFIG_LOCATION, filepath.Ext(CONFIG_LOCATION))
+	for _, ext := range []string{".yaml", ".yml"} {
+		if utils.CheckFileExists(base + ext) {
+			return base + ext
+		}
+	}
+	return CONFIG_LOCATION
+}
+
+// FormatOf returns the format of a config file from its extension.
+func FormatOf(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	}
+	return FormatJSON
+}
+
+// ReadFile reads a config file and checks it against our config schema.
+// Unknown fields are available from Warnings.
+// Note: Use Validate to check the config values.
+func ReadFile(file string) (*Config, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b, FormatOf(file))
+}
+
+// Parse decodes a JSON or YAML config and checks it against our config schema.
+func Parse(data []byte, format string) (*Config, error) {
+	var value interface{}
+	switch format {
+	case FormatYAML:
+		if err := yaml.Unmarshal(data, &value); err != nil {
+			return nil, errors.New("invalid YAML: " + err.Error())
+		}
+	case FormatJSON:
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, errors.New("invalid JSON: " + err.Error())
+		}
+	default:
+		return nil, errors.New("unsupported config format " + format)
+	}
+	warnings, err := ValidateSchema(value)
+	if err != nil {
+		return nil, err
+	}
+	// Our schema matches our config struct so this only fails on values we can't represent
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{warnings: warnings}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// encode converts a config into the format of its config file.
+// Note: The caller must hold the config lock.
+func (c *Config) encode(format string) ([]byte, error) {
+	b, err := json.MarshalIndent(c, "", "    ")
+	if err != nil || format != FormatYAML {
+		return b, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(value)
+}
@@ -0,0 +1,455 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"plugin"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// An object with a fixed set of fields
+	SchemaObject = "object"
+	// An object with any keys
+	SchemaMap     = "map"
+	SchemaArray   = "array"
+	SchemaString  = "string"
+	SchemaInteger = "integer"
+	SchemaNumber  = "number"
+	SchemaBoolean = "boolean"
+	// Any value
+	SchemaAny = "any"
+)
+
+// Schema describes the expected structure of a config value.
+type Schema struct {
+	Type string `json:"type"`
+	// The fields of an object. Unknown fields are reported as warnings
+	Fields map[string]*Schema `json:"fields,omitempty"`
+	// The schema of each value of a map
+	Values *Schema `json:"values,omitempty"`
+	// The schema of each item of an array
+	Items *Schema `json:"items,omitempty"`
+	// Whether the value may be null
+	Nullable bool `json:"nullable,omitempty"`
+	// The allowed values of a string
+	// Note: An empty string is always allowed so the default value is used.
+	Enum []string `json:"enum,omitempty"`
+	// The smallest allowed number
+	Min *float64 `json:"min,omitempty"`
+}
+
+// SchemaError defines a config value that doesn't match its schema.
+type SchemaError struct {
+	// The path to the value e.g. nodes.<uuid>.bind_port
+	Path    string
+	Message string
+}
+
+// Error returns the path to the value followed by what is wrong with it.
+func (e *SchemaError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// Validate checks a decoded JSON or YAML value against the schema.
+// Unknown fields are ignored and returned as warnings so a misspelt or newer key doesn't stop PulseHA from starting.
+func (s *Schema) Validate(value interface{}) ([]string, error) {
+	var warnings []string
+	err := s.validate("", value, &warnings)
+	return warnings, err
+}
+
+// validate checks a value and everything it contains against the schema.
+func (s *Schema) validate(path string, value interface{}, warnings *[]string) error {
+	if value == nil {
+		if s.Nullable || s.Type == SchemaAny {
+			return nil
+		}
+		return &SchemaError{Path: path, Message: "expected " + describeSchemaType(s.Type) + ", got null"}
+	}
+	switch s.Type {
+	case SchemaAny:
+		return nil
+	case SchemaObject, SchemaMap:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return schemaTypeError(path, s.Type, value)
+		}
+		for _, key := range sortedKeys(object) {
+			field := s.Values
+			if s.Type == SchemaObject {
+				field = s.Fields[key]
+				if field == nil {
+					*warnings = append(*warnings, (&SchemaError{Path: joinSchemaPath(path, key), Message: "unknown field"}).Error())
+					continue
+				}
+			}
+			if field == nil {
+				continue
+			}
+			if err := field.validate(joinSchemaPath(path, key), object[key], warnings); err != nil {
+				return err
+			}
+		}
+	case SchemaArray:
+		array, ok := value.([]interface{})
+		if !ok {
+			return schemaTypeError(path, s.Type, value)
+		}
+		if s.Items == nil {
+			return nil
+		}
+		for i, item := range array {
+			if err := s.Items.validate(path+"["+strconv.Itoa(i)+"]", item, warnings); err != nil {
+				return err
+			}
+		}
+	case SchemaString:
+		str, ok := value.(string)
+		if !ok {
+			return schemaTypeError(path, s.Type, value)
+		}
+		if str == "" || len(s.Enum) == 0 {
+			return nil
+		}
+		for _, allowed := range s.Enum {
+			if str == allowed {
+				return nil
+			}
+		}
+		return &SchemaError{Path: path, Message: "must be one of " + strings.Join(s.Enum, ", ")}
+	case SchemaInteger, SchemaNumber:
+		number, ok := schemaNumber(value)
+		if !ok || (s.Type == SchemaInteger && number != float64(int64(number))) {
+			return schemaTypeError(path, s.Type, value)
+		}
+		if s.Min != nil && number < *s.Min {
+			return &SchemaError{Path: path, Message: "must be at least " + strconv.FormatFloat(*s.Min, 'f', -1, 64)}
+		}
+	case SchemaBoolean:
+		if _, ok := value.(bool); !ok {
+			return schemaTypeError(path, s.Type, value)
+		}
+	default:
+		return &SchemaError{Path: path, Message: "unknown schema type " + s.Type}
+	}
+	return nil
+}
+
+// schemaTypeError reports a value of the wrong type.
+func schemaTypeError(path string, expected string, value interface{}) error {
+	return &SchemaError{
+		Path:    path,
+		Message: "expected " + describeSchemaType(expected) + ", got " + describeValueType(value),
+	}
+}
+
+// describeSchemaType describes a schema type for our error messages.
+func describeSchemaType(t string) string {
+	switch t {
+	case SchemaObject, SchemaMap:
+		return "an object"
+	case SchemaArray:
+		return "an array"
+	case SchemaInteger:
+		return "an integer"
+	case SchemaAny:
+		return "any value"
+	}
+	return "a " + t
+}
+
+// describeValueType describes the type of a decoded value for our error messages.
+func describeValueType(value interface{}) string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	default:
+		if number, ok := schemaNumber(v); ok {
+			if number == float64(int64(number)) {
+				return "an integer"
+			}
+			return "a number"
+		}
+	}
+	return "an unsupported value"
+}
+
+// schemaNumber converts the numbers produced by our JSON and YAML decoders.
+func schemaNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// joinSchemaPath adds a key to a path.
+func joinSchemaPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// sortedKeys returns the keys of an object in order so errors are reported consistently.
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SchemaOf creates a schema from a struct using its json tags.
+// Constraints are set with a schema tag e.g. `schema:"min=100"` or `schema:"enum=grpc|udp"`.
+func SchemaOf(v interface{}) *Schema {
+	return schemaOfType(reflect.TypeOf(v))
+}
+
+// schemaOfType creates the schema for a Go type.
+func schemaOfType(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		s := schemaOfType(t.Elem())
+		s.Nullable = true
+		return s
+	case reflect.Struct:
+		s := &Schema{Type: SchemaObject, Fields: map[string]*Schema{}}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous || field.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			fieldSchema := schemaOfType(field.Type)
+			applySchemaTag(fieldSchema, field.Tag.Get("schema"))
+			s.Fields[name] = fieldSchema
+		}
+		return s
+	case reflect.Map:
+		return &Schema{Type: SchemaMap, Values: schemaOfType(t.Elem()), Nullable: true}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: SchemaArray, Items: schemaOfType(t.Elem()), Nullable: true}
+	case reflect.String:
+		return &Schema{Type: SchemaString}
+	case reflect.Bool:
+		return &Schema{Type: SchemaBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: SchemaInteger}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		min := 0.0
+		return &Schema{Type: SchemaInteger, Min: &min}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: SchemaNumber}
+	}
+	return &Schema{Type: SchemaAny}
+}
+
+// applySchemaTag applies the constraints in a schema tag.
+func applySchemaTag(s *Schema, tag string) {
+	for _, option := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(option, "=")
+		switch key {
+		case "min":
+			if min, err := strconv.ParseFloat(value, 64); err == nil {
+				s.Min = &min
+			}
+		case "enum":
+			s.Enum = strings.Split(value, "|")
+		}
+	}
+}
+
+var (
+	configSchema     *Schema
+	configSchemaOnce sync.Once
+	pluginSchemas    = map[string]*Schema{}
+	pluginSchemasMu  sync.Mutex
+)
+
+// ConfigSchema returns the schema of the config file.
+// Note: Each plugin section is checked against the schema registered by its plugin.
+func ConfigSchema() *Schema {
+	configSchemaOnce.Do(func() {
+		configSchema = SchemaOf(Config{})
+	})
+	return configSchema
+}
+
+// RegisterPluginSchema registers the schema of a plugin's config section.
+func RegisterPluginSchema(name string, schema *Schema) {
+	pluginSchemasMu.Lock()
+	defer pluginSchemasMu.Unlock()
+	pluginSchemas[name] = schema
+}
+
+// GetPluginSchema returns the schema registered for a plugin's config section.
+func GetPluginSchema(name string) *Schema {
+	pluginSchemasMu.Lock()
+	defer pluginSchemasMu.Unlock()
+	return pluginSchemas[name]
+}
+
+// SchemaProvider is implemented by plugins that describe their config section.
+type SchemaProvider interface {
+	Name() string
+	Schema() *Schema
+}
+
+// pluginSymbols are the symbols plugins are exported as.
+var pluginSymbols = []string{"PluginHC", "PluginNet", "PluginGeneral", "PluginFence"}
+
+// LoadPluginSchemas registers the schema of every plugin in a folder that describes its config section.
+// Returns an error for each plugin that could not be opened.
+// Note: Called before the config is loaded so plugin sections are checked when it is.
+func LoadPluginSchemas(dir string) []error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.so"))
+	if err != nil {
+		return []error{err}
+	}
+	var errs []error
+	for _, file := range files {
+		p, err := plugin.Open(file)
+		if err != nil {
+			errs = append(errs, errors.New("unable to load plugin "+file+": "+err.Error()))
+			continue
+		}
+		for _, symbol := range pluginSymbols {
+			sym, err := p.Lookup(symbol)
+			if err != nil {
+				continue
+			}
+			if provider, ok := sym.(SchemaProvider); ok {
+				RegisterPluginSchema(provider.Name(), provider.Schema())
+			}
+		}
+	}
+	return errs
+}
+
+// PluginSchemaFile defines a schema file shipped next to a plugin e.g. hcping.schema.json for hcping.so.
+// Note: Lets a plugin's config section be checked without running the plugin.
+type PluginSchemaFile struct {
+	// The name of the plugin's config section
+	Name   string  `json:"name"`
+	Schema *Schema `json:"schema"`
+}
+
+// LoadPluginSchemaFiles registers the schema of every plugin schema file in a folder.
+// Returns an error for each file that could not be read.
+func LoadPluginSchemaFiles(dir string) []error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.schema.json"))
+	if err != nil {
+		return []error{err}
+	}
+	var errs []error
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			errs = append(errs, errors.New("unable to read plugin schema "+file+": "+err.Error()))
+			continue
+		}
+		var schemaFile PluginSchemaFile
+		if err := json.Unmarshal(data, &schemaFile); err != nil || schemaFile.Name == "" || schemaFile.Schema == nil {
+			errs = append(errs, errors.New("invalid plugin schema "+file))
+			continue
+		}
+		RegisterPluginSchema(schemaFile.Name, schemaFile.Schema)
+	}
+	return errs
+}
+
+// ValidateSchema checks a decoded config file against our config schema and the registered plugin schemas.
+// Returns the unknown fields as warnings.
+func ValidateSchema(value interface{}) ([]string, error) {
+	warnings, err := ConfigSchema().Validate(value)
+	if err != nil {
+		return nil, err
+	}
+	object, _ := value.(map[string]interface{})
+	plugins, _ := object["plugins"].(map[string]interface{})
+	for _, name := range sortedKeys(plugins) {
+		if schema := GetPluginSchema(name); schema != nil {
+			if err := schema.validate("plugins."+name, plugins[name], &warnings); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// DecodePluginConfig checks a plugin's config section against its registered schema and decodes it.
+func (c *Config) DecodePluginConfig(name string, out interface{}) error {
+	c.Lock()
+	section, ok := c.Plugins[name]
+	c.Unlock()
+	if !ok {
+		return errors.New("plugin does not exist in config")
+	}
+	// Round trip the section so typed plugin configs set in memory are checked the same way
+	b, err := json.Marshal(section)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	// Unknown fields were reported when the config was loaded
+	if schema := GetPluginSchema(name); schema != nil {
+		if err := schema.validate("plugins."+name, value, &[]string{}); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(b, out)
+}
@@ -0,0 +1,219 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"github.com/syleron/pulseha/packages/utils"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testConfigJSON = `{
+    "pulseha": {
+        "hcs_interval": 1000,
+        "fos_interval": 5000,
+        "fo_limit": 10000,
+        "failure_detector": "phi_accrual"
+    },
+    "floating_ip_groups": {
+        "group1": ["10.0.0.10/24"]
+    },
+    "nodes": {
+        "abc": {
+            "hostname": "node1",
+            "bind_address": "10.0.0.1",
+            "bind_port": "1234",
+            "group_assignments": {"eth0": ["group1"]}
+        }
+    },
+    "plugins": {}
+}`
+
+const testConfigYAML = `
+pulseha:
+  hcs_interval: 1000
+  fos_interval: 5000
+  fo_limit: 10000
+  failure_detector: phi_accrual
+floating_ip_groups:
+  group1: ["10.0.0.10/24"]
+nodes:
+  abc:
+    hostname: node1
+    bind_address: 10.0.0.1
+    bind_port: "1234"
+    group_assignments:
+      eth0: [group1]
+plugins: {}
+`
+
+func TestParseYAMLMatchesJSON(t *testing.T) {
+	fromJSON, err := Parse([]byte(testConfigJSON), FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromYAML, err := Parse([]byte(testConfigYAML), FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromJSON.Hash() != fromYAML.Hash() || !reflect.DeepEqual(fromJSON.Pulse, fromYAML.Pulse) {
+		t.Error("expected the YAML config to match the JSON config")
+	}
+}
+
+func TestParseNamesTheBadPath(t *testing.T) {
+	tests := map[string]string{
+		`{"pulseha": {"fo_limit": "10000"}}`:                             "pulseha.fo_limit: expected an integer, got a string",
+		`{"pulseha": {"fo_limit": 50}}`:                                  "pulseha.fo_limit: must be at least 100",
+		`{"pulseha": {"heartbeat_transport": "tcp"}}`:                    "pulseha.heartbeat_transport: must be one of grpc, udp",
+		`{"nodes": {"abc": {"bind_port": 1234}}}`:                        "nodes.abc.bind_port: expected a string, got an integer",
+		`{"nodes": {"abc": {"heartbeat_addresses": ["10.0.0.2:1", 2]}}}`: "nodes.abc.heartbeat_addresses[1]: expected a string, got an integer",
+		`{"floating_ip_groups": []}`:                                     "floating_ip_groups: expected an object, got an array",
+	}
+	for data, expected := range tests {
+		_, err := Parse([]byte(data), FormatJSON)
+		if err == nil || err.Error() != expected {
+			t.Errorf("expected %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestParseWarnsOnUnknownFields(t *testing.T) {
+	c, err := Parse([]byte(`{"pulseha": {"hcs_intervall": 1000}, "nodes": {"abc": {"hostnme": "node1"}}}`), FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"nodes.abc.hostnme: unknown field", "pulseha.hcs_intervall: unknown field"}
+	if !reflect.DeepEqual(c.Warnings(), expected) {
+		t.Errorf("expected %v, got %v", expected, c.Warnings())
+	}
+}
+
+type testPluginConfig struct {
+	Weight int `json:"weight" schema:"min=0"`
+	Groups []struct {
+		Name string   `json:"name"`
+		Ips  []string `json:"ips"`
+	} `json:"groups"`
+}
+
+func TestPluginSchema(t *testing.T) {
+	RegisterPluginSchema("TestPlugin", SchemaOf(testPluginConfig{}))
+	_, err := Parse([]byte(`{"plugins": {"TestPlugin": {"weight": -1}}}`), FormatJSON)
+	if err == nil || err.Error() != "plugins.TestPlugin.weight: must be at least 0" {
+		t.Errorf("expected the plugin section to be checked, got %v", err)
+	}
+	c, err := Parse([]byte(`{"plugins": {"TestPlugin": {"weight": 10, "groups": [{"name": "a", "ipz": []}]}}}`), FormatJSON)
+	if err != nil || len(c.Warnings()) != 1 || c.Warnings()[0] != "plugins.TestPlugin.groups[0].ipz: unknown field" {
+		t.Errorf("expected an unknown field in the plugin section to be a warning, got %v %v", c, err)
+	}
+	// Plugins without a registered schema aren't checked
+	if _, err := Parse([]byte(`{"plugins": {"OtherPlugin": {"anything": true}}}`), FormatJSON); err != nil {
+		t.Error(err)
+	}
+	c = &Config{Plugins: map[string]interface{}{
+		"TestPlugin": map[string]interface{}{"weight": "10"},
+	}}
+	var pluginConfig testPluginConfig
+	if err := c.DecodePluginConfig("TestPlugin", &pluginConfig); err == nil || err.Error() != "plugins.TestPlugin.weight: expected an integer, got a string" {
+		t.Errorf("expected a typed error instead of a panic, got %v", err)
+	}
+	c.Plugins["TestPlugin"] = map[string]interface{}{"weight": 5.0}
+	if err := c.DecodePluginConfig("TestPlugin", &pluginConfig); err != nil || pluginConfig.Weight != 5 {
+		t.Errorf("expected the plugin config to be decoded, got %+v %v", pluginConfig, err)
+	}
+}
+
+func TestSaveKeepsYAMLFormat(t *testing.T) {
+	setupTestConfigLocation(t)
+	dir := filepath.Dir(CONFIG_LOCATION)
+	c, err := Parse([]byte(testConfigYAML), FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	yamlFile := filepath.Join(dir, "config.yaml")
+	data, err := c.encode(FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := utils.WriteFileAtomic(yamlFile, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if File() != yamlFile {
+		t.Fatalf("expected the YAML config file to be used, got %s", File())
+	}
+	// Saving checks the local node is in the config
+	hostname, err := utils.GetHostname()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Nodes["abc"].Hostname = hostname
+	c.Nodes["abc"].Priority = 5
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := ReadFile(yamlFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(yamlFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Nodes["abc"].Priority != 5 || strings.HasPrefix(string(b), "{") {
+		t.Error("expected the config to be saved as YAML")
+	}
+}
+
+func TestLoadPluginSchemasReportsBadPlugins(t *testing.T) {
+	dir := t.TempDir()
+	if errs := LoadPluginSchemas(dir); len(errs) != 0 {
+		t.Errorf("expected no errors for an empty plugin folder, got %v", errs)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "bad.so"), []byte("not a plugin"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if errs := LoadPluginSchemas(dir); len(errs) != 1 {
+		t.Errorf("expected an error for a plugin that can't be opened, got %v", errs)
+	}
+}
+
+func TestLoadPluginSchemaFiles(t *testing.T) {
+	dir := t.TempDir()
+	schema := `{"name": "FileHC", "schema": {"type": "object", "fields": {"weight": {"type": "integer", "min": 0}}}}`
+	if err := ioutil.WriteFile(filepath.Join(dir, "filehc.schema.json"), []byte(schema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "bad.schema.json"), []byte(`{"name": "BadHC"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if errs := LoadPluginSchemaFiles(dir); len(errs) != 1 {
+		t.Errorf("expected an error for the invalid schema file, got %v", errs)
+	}
+	if GetPluginSchema("BadHC") != nil {
+		t.Error("expected the invalid schema file not to be registered")
+	}
+	_, err := ValidateSchema(map[string]interface{}{
+		"plugins": map[string]interface{}{"FileHC": map[string]interface{}{"weight": -1.0}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "plugins.FileHC.weight") {
+		t.Errorf("expected the plugin section to be checked against its schema file, got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fencing
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"github.com/syleron/pulseha/packages/config"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	DriverExec    = "exec"
+	DriverRedfish = "redfish"
+)
+
+// Driver defines a fencing driver used to isolate a failed node.
+type Driver interface {
+	Name() string
+	Fence(ctx context.Context, hostname string) error
+}
+
+// New returns the built in driver for a node fencing definition.
+func New(cfg *config.Fencing) (Driver, error) {
+	if cfg == nil {
+		return nil, errors.New("no fencing definition provided")
+	}
+	switch cfg.Driver {
+	case DriverExec:
+		if cfg.Command == "" {
+			return nil, errors.New("exec fencing driver requires a command")
+		}
+		return &Exec{Command: cfg.Command, Args: cfg.Args}, nil
+	case DriverRedfish:
+		if cfg.Address == "" {
+			return nil, errors.New("redfish fencing driver requires an address")
+		}
+		return &Redfish{
+			Address:  cfg.Address,
+			System:   cfg.System,
+			Username: cfg.Username,
+			Password: cfg.Password,
+			Insecure: cfg.Insecure,
+		}, nil
+	}
+	return nil, errors.New("unknown fencing driver " + cfg.Driver)
+}
+
+// IsBuiltin returns whether a driver name refers to one of our built in drivers.
+func IsBuiltin(driver string) bool {
+	return driver == DriverExec || driver == DriverRedfish
+}
+
+// Exec fences a node by executing a script or command.
+// Note: The hostname being fenced is appended as the last argument and set as
+// the PULSEHA_FENCE_NODE environment variable. A zero exit code means success.
+type Exec struct {
+	Command string
+	Args    []string
+}
+
+// Name returns the name of the driver.
+func (e *Exec) Name() string {
+	return DriverExec
+}
+
+// Fence executes our fencing command.
+func (e *Exec) Fence(ctx context.Context, hostname string) error {
+	args := append(append([]string{}, e.Args...), hostname)
+	cmd := exec.CommandContext(ctx, e.Command, args...)
+	cmd.Env = append(os.Environ(), "PULSEHA_FENCE_NODE="+hostname)
+	output, err := cmd.CombinedOutput()
+	if ctx.Err() != nil {
+		return errors.New("fencing command timed out")
+	}
+	if err != nil {
+		return errors.New("fencing command failed: " + err.Error() + " " + strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// Redfish fences a node by powering it off through its BMC's Redfish API.
+type Redfish struct {
+	// The base URL of the BMC e.g. https://10.0.0.10
+	Address string
+	// The Redfish system id. Defaults to 1
+	System   string
+	Username string
+	Password string
+	// Skip BMC certificate verification
+	Insecure bool
+	// How often to poll the power state once the reset was requested
+	PollInterval time.Duration
+	// The http client to use. One is created when not set
+	Client *http.Client
+}
+
+// Name returns the name of the driver.
+func (r *Redfish) Name() string {
+	return DriverRedfish
+}
+
+// Fence powers off the system and waits until the BMC reports it as off.
+func (r *Redfish) Fence(ctx context.Context, hostname string) error {
+	body, err := json.Marshal(map[string]string{"ResetType": "ForceOff"})
+	if err != nil {
+		return err
+	}
+	resp, err := r.do(ctx, http.MethodPost, r.systemPath()+"/Actions/ComputerSystem.Reset", body)
+	if err != nil {
+		return errors.New("redfish reset request failed: " + err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.New("redfish reset request returned " + resp.Status)
+	}
+	interval := r.PollInterval
+	if interval == 0 {
+		interval = time.Second
+	}
+	// Wait for the BMC to confirm the system is off
+	for {
+		state, err := r.powerState(ctx)
+		if err == nil && strings.EqualFold(state, "Off") {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return errors.New("unable to confirm " + hostname + " is powered off: " + err.Error())
+			}
+			return errors.New("unable to confirm " + hostname + " is powered off. Last power state: " + state)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// powerState returns the current power state reported by the BMC.
+func (r *Redfish) powerState(ctx context.Context) (string, error) {
+	resp, err := r.do(ctx, http.MethodGet, r.systemPath(), nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New("redfish system request returned " + resp.Status)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	system := struct {
+		PowerState string `json:"PowerState"`
+	}{}
+	if err := json.Unmarshal(b, &system); err != nil {
+		return "", err
+	}
+	return system.PowerState, nil
+}
+
+// systemPath returns the path to our Redfish system resource.
+func (r *Redfish) systemPath() string {
+	system := r.System
+	if system == "" {
+		system = "1"
+	}
+	return "/redfish/v1/Systems/" + system
+}
+
+// do performs an authenticated request against the BMC.
+func (r *Redfish) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(r.Address, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.Username != "" {
+		req.SetBasicAuth(r.Username, r.Password)
+	}
+	c := r.Client
+	if c == nil {
+		c = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: r.Insecure},
+			},
+		}
+	}
+	return c.Do(req)
+}
@@ -0,0 +1,142 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fencing
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/syleron/pulseha/packages/config"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// redfishStub is a minimal BMC exposing a single Redfish system.
+type redfishStub struct {
+	powerState string
+	resets     int
+	sync.Mutex
+}
+
+func (s *redfishStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset":
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["ResetType"] != "ForceOff" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.resets++
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == "/redfish/v1/Systems/1":
+		state := s.powerState
+		// The system powers off after the first poll
+		if s.resets > 0 {
+			s.powerState = "Off"
+		}
+		json.NewEncoder(w).Encode(map[string]string{"PowerState": state})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRedfishFence(t *testing.T) {
+	stub := &redfishStub{powerState: "On"}
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+	r := &Redfish{
+		Address:      srv.URL,
+		Username:     "admin",
+		Password:     "secret",
+		PollInterval: 10 * time.Millisecond,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := r.Fence(ctx, "node1"); err != nil {
+		t.Fatalf("expected fence to succeed: %v", err)
+	}
+	if stub.resets != 1 {
+		t.Errorf("expected 1 reset request, got %d", stub.resets)
+	}
+}
+
+func TestRedfishFenceUnauthorized(t *testing.T) {
+	srv := httptest.NewServer(&redfishStub{powerState: "On"})
+	defer srv.Close()
+	r := &Redfish{Address: srv.URL, Username: "admin", Password: "wrong"}
+	if err := r.Fence(context.Background(), "node1"); err == nil {
+		t.Error("expected fence to fail with invalid credentials")
+	}
+}
+
+func TestRedfishFenceTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"PowerState": "On"})
+	}))
+	defer srv.Close()
+	r := &Redfish{Address: srv.URL, PollInterval: 10 * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := r.Fence(ctx, "node1"); err == nil {
+		t.Error("expected fence to time out while the system remains on")
+	}
+}
+
+func TestExecFence(t *testing.T) {
+	e := &Exec{Command: "sh", Args: []string{"-c", `test "$PULSEHA_FENCE_NODE" = "$0"`}}
+	if err := e.Fence(context.Background(), "node1"); err != nil {
+		t.Errorf("expected fence to succeed: %v", err)
+	}
+	e = &Exec{Command: "false"}
+	if err := e.Fence(context.Background(), "node1"); err == nil {
+		t.Error("expected fence to fail on a non-zero exit code")
+	}
+}
+
+func TestExecFenceTimeout(t *testing.T) {
+	e := &Exec{Command: "sleep", Args: []string{"5"}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := e.Fence(ctx, ""); err == nil {
+		t.Error("expected fence to time out")
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(&config.Fencing{Driver: DriverExec}); err == nil {
+		t.Error("expected exec driver without a command to fail")
+	}
+	if _, err := New(&config.Fencing{Driver: "unknown"}); err == nil {
+		t.Error("expected unknown driver to fail")
+	}
+	d, err := New(&config.Fencing{Driver: DriverRedfish, Address: "https://127.0.0.1"})
+	if err != nil || d.Name() != DriverRedfish {
+		t.Errorf("expected redfish driver, got %v %v", d, err)
+	}
+}
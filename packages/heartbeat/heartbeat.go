@@ -0,0 +1,293 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package heartbeat
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// A health check sent by the active member
+	TypeRequest byte = 1
+	// The reply to a health check
+	TypeResponse byte = 2
+	// The largest datagram we send or accept
+	MaxPacketSize = 65507
+	// The number of senders we remember the last sequence number of
+	maxSessions = 1024
+)
+
+var (
+	ErrMalformed = errors.New("malformed heartbeat packet")
+	ErrSignature = errors.New("invalid heartbeat signature")
+	ErrReplay    = errors.New("replayed heartbeat packet")
+	ErrExpired   = errors.New("expired heartbeat packet")
+	ErrNoKey     = errors.New("no heartbeat key available")
+)
+
+// The packet layout is the magic, type, session, sequence number, acknowledged sequence
+// number, time sent and payload followed by an HMAC-SHA256 of everything before it.
+var magic = []byte("PHB2")
+
+const headerSize = 4 + 1 + 8 + 8 + 8 + 8
+
+// Packet defines a single heartbeat datagram.
+type Packet struct {
+	Type byte
+	// Random identifier chosen by the sender each time it starts
+	Session uint64
+	// Increases with every packet the sender sends
+	Seq uint64
+	// The sequence number of the request a response answers
+	Ack uint64
+	// When the packet was sent in Unix nanoseconds
+	Time    int64
+	Payload []byte
+}
+
+// Seal encodes and signs a packet.
+func Seal(key []byte, p *Packet) []byte {
+	data := make([]byte, headerSize, headerSize+len(p.Payload)+sha256.Size)
+	copy(data, magic)
+	data[4] = p.Type
+	binary.BigEndian.PutUint64(data[5:], p.Session)
+	binary.BigEndian.PutUint64(data[13:], p.Seq)
+	binary.BigEndian.PutUint64(data[21:], p.Ack)
+	binary.BigEndian.PutUint64(data[29:], uint64(p.Time))
+	data = append(data, p.Payload...)
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(data)
+}
+
+// Open verifies and decodes a packet.
+func Open(key []byte, data []byte) (*Packet, error) {
+	if len(data) < headerSize+sha256.Size || string(data[:4]) != string(magic) {
+		return nil, ErrMalformed
+	}
+	body := data[:len(data)-sha256.Size]
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), data[len(body):]) {
+		return nil, ErrSignature
+	}
+	return &Packet{
+		Type:    body[4],
+		Session: binary.BigEndian.Uint64(body[5:]),
+		Seq:     binary.BigEndian.Uint64(body[13:]),
+		Ack:     binary.BigEndian.Uint64(body[21:]),
+		Time:    int64(binary.BigEndian.Uint64(body[29:])),
+		Payload: append([]byte{}, body[headerSize:]...),
+	}, nil
+}
+
+// KeyFunc returns the shared key used to sign heartbeats.
+// Note: Called for every packet so the key can change while the connection is open.
+type KeyFunc func() []byte
+
+// Conn sends and receives signed heartbeat datagrams.
+type Conn struct {
+	conn    *net.UDPConn
+	key     KeyFunc
+	session uint64
+	seq     uint64
+	// How far the time a packet was sent may be from our own clock
+	window time.Duration
+	// The last packet received from each sender session
+	seen map[uint64]received
+	sync.Mutex
+}
+
+// received defines the last packet received from a sender session.
+type received struct {
+	Seq uint64
+	At  time.Time
+}
+
+// Listen creates a heartbeat connection bound to a unicast ip:port address.
+// Packets sent more than window before or after our own clock are rejected.
+func Listen(address string, key KeyFunc, window time.Duration) (*Conn, error) {
+	addr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return newConn(conn, key, window), nil
+}
+
+// ListenMulticast creates a heartbeat connection that joins a multicast group ip:port address.
+// Note: Unicast packets sent to the group port are received as well.
+func ListenMulticast(group string, key KeyFunc, window time.Duration) (*Conn, error) {
+	addr, err := net.ResolveUDPAddr("udp", group)
+	if err != nil {
+		return nil, err
+	}
+	if !addr.IP.IsMulticast() {
+		return nil, errors.New(group + " is not a multicast address")
+	}
+	conn, err := net.ListenMulticastUDP("udp", nil, addr)
+	if err != nil {
+		return nil, err
+	}
+	return newConn(conn, key, window), nil
+}
+
+// newConn wraps a UDP connection with a new random session.
+func newConn(conn *net.UDPConn, key KeyFunc, window time.Duration) *Conn {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return &Conn{
+		conn:    conn,
+		key:     key,
+		session: binary.BigEndian.Uint64(b),
+		window:  window,
+		seen:    map[uint64]received{},
+	}
+}
+
+// LocalAddr returns the address the connection is bound to.
+func (c *Conn) LocalAddr() *net.UDPAddr {
+	return c.conn.LocalAddr().(*net.UDPAddr)
+}
+
+// signingKey returns the current key or an error if there isn't one.
+func (c *Conn) signingKey() ([]byte, error) {
+	key := c.key()
+	if len(key) == 0 {
+		return nil, ErrNoKey
+	}
+	return key, nil
+}
+
+// Send signs and sends a packet to each address and returns its sequence number.
+// Note: Sending the same packet to more than one address only delivers it once per receiver.
+func (c *Conn) Send(to []*net.UDPAddr, packetType byte, ack uint64, payload []byte) (uint64, error) {
+	key, err := c.signingKey()
+	if err != nil {
+		return 0, err
+	}
+	c.Lock()
+	c.seq++
+	seq := c.seq
+	c.Unlock()
+	data := Seal(key, &Packet{
+		Type:    packetType,
+		Session: c.session,
+		Seq:     seq,
+		Ack:     ack,
+		Time:    time.Now().UnixNano(),
+		Payload: payload,
+	})
+	if len(data) > MaxPacketSize {
+		return 0, errors.New("heartbeat packet is too large")
+	}
+	var sendErr error
+	sent := false
+	for _, addr := range to {
+		if _, err := c.conn.WriteToUDP(data, addr); err != nil {
+			sendErr = err
+			continue
+		}
+		sent = true
+	}
+	if !sent {
+		if sendErr == nil {
+			sendErr = errors.New("no heartbeat addresses to send to")
+		}
+		return 0, sendErr
+	}
+	return seq, nil
+}
+
+// Receive waits for the next packet.
+// Packets we sent ourselves are skipped. Invalid, replayed and expired packets are returned as an error
+// along with the address they came from.
+func (c *Conn) Receive() (*Packet, *net.UDPAddr, error) {
+	buf := make([]byte, MaxPacketSize)
+	for {
+		n, from, err := c.conn.ReadFromUDP(buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		key, err := c.signingKey()
+		if err != nil {
+			return nil, from, err
+		}
+		p, err := Open(key, buf[:n])
+		if err != nil {
+			return nil, from, err
+		}
+		// Ignore our own multicast packets
+		if p.Session == c.session {
+			continue
+		}
+		if err := c.accept(p, time.Now()); err != nil {
+			return nil, from, err
+		}
+		return p, from, nil
+	}
+}
+
+// accept records the sequence number of a packet and returns an error if it was already received
+// or was not sent within our window.
+// Note: The packets of an evicted session could only be replayed while they are within the window.
+func (c *Conn) accept(p *Packet, now time.Time) error {
+	sent := time.Unix(0, p.Time)
+	if sent.Before(now.Add(-c.window)) || sent.After(now.Add(c.window)) {
+		return ErrExpired
+	}
+	c.Lock()
+	defer c.Unlock()
+	if last, ok := c.seen[p.Session]; ok && p.Seq <= last.Seq {
+		return ErrReplay
+	}
+	if _, ok := c.seen[p.Session]; !ok && len(c.seen) >= maxSessions {
+		c.evictOldest()
+	}
+	c.seen[p.Session] = received{Seq: p.Seq, At: now}
+	return nil
+}
+
+// evictOldest forgets the session we last received a packet from the longest time ago.
+// Note: The caller must hold the connection lock.
+func (c *Conn) evictOldest() {
+	var oldest uint64
+	var oldestAt time.Time
+	for session, last := range c.seen {
+		if oldestAt.IsZero() || last.At.Before(oldestAt) {
+			oldest, oldestAt = session, last.At
+		}
+	}
+	delete(c.seen, oldest)
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,182 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package heartbeat
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+)
+
+func testKey() []byte {
+	return []byte("secret")
+}
+
+// testListen opens a heartbeat connection on a random loopback port.
+func testListen(t *testing.T, key KeyFunc) *Conn {
+	c, err := Listen("127.0.0.1:0", key, 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// testReceive waits for a packet with a deadline so a lost packet fails the test instead of hanging.
+func testReceive(t *testing.T, c *Conn) (*Packet, *net.UDPAddr, error) {
+	c.conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	return c.Receive()
+}
+
+func TestSealOpen(t *testing.T) {
+	p := &Packet{Type: TypeResponse, Session: 1, Seq: 2, Ack: 3, Time: time.Now().UnixNano(), Payload: []byte("payload")}
+	got, err := Open(testKey(), Seal(testKey(), p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != p.Type || got.Session != p.Session || got.Seq != p.Seq || got.Ack != p.Ack || got.Time != p.Time || !bytes.Equal(got.Payload, p.Payload) {
+		t.Errorf("expected %+v, got %+v", p, got)
+	}
+}
+
+func TestOpenRejectsTampering(t *testing.T) {
+	data := Seal(testKey(), &Packet{Type: TypeRequest, Seq: 1, Payload: []byte("payload")})
+	data[headerSize] ^= 0xff
+	if _, err := Open(testKey(), data); err != ErrSignature {
+		t.Errorf("expected %v, got %v", ErrSignature, err)
+	}
+}
+
+func TestOpenRejectsWrongKey(t *testing.T) {
+	data := Seal(testKey(), &Packet{Type: TypeRequest, Seq: 1})
+	if _, err := Open([]byte("other"), data); err != ErrSignature {
+		t.Errorf("expected %v, got %v", ErrSignature, err)
+	}
+}
+
+func TestOpenRejectsMalformed(t *testing.T) {
+	if _, err := Open(testKey(), []byte("PHB1")); err != ErrMalformed {
+		t.Errorf("expected %v, got %v", ErrMalformed, err)
+	}
+}
+
+func TestLoopbackExchange(t *testing.T) {
+	a := testListen(t, testKey)
+	b := testListen(t, testKey)
+	seq, err := a.Send([]*net.UDPAddr{b.LocalAddr()}, TypeRequest, 0, []byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, from, err := testReceive(t, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Type != TypeRequest || p.Seq != seq || string(p.Payload) != "ping" {
+		t.Fatalf("unexpected request %+v", p)
+	}
+	if _, err := b.Send([]*net.UDPAddr{from}, TypeResponse, p.Seq, []byte("pong")); err != nil {
+		t.Fatal(err)
+	}
+	p, _, err = testReceive(t, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Type != TypeResponse || p.Ack != seq || string(p.Payload) != "pong" {
+		t.Errorf("unexpected response %+v", p)
+	}
+}
+
+func TestReceiveRejectsReplay(t *testing.T) {
+	a := testListen(t, testKey)
+	b := testListen(t, testKey)
+	data := Seal(testKey(), &Packet{Type: TypeRequest, Session: 7, Seq: 1, Time: time.Now().UnixNano()})
+	for i := 0; i < 2; i++ {
+		if _, err := a.conn.WriteToUDP(data, b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := testReceive(t, b); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := testReceive(t, b); err != ErrReplay {
+		t.Errorf("expected %v, got %v", ErrReplay, err)
+	}
+}
+
+func TestReceiveRejectsUnsigned(t *testing.T) {
+	a := testListen(t, func() []byte { return []byte("other") })
+	b := testListen(t, testKey)
+	if _, err := a.Send([]*net.UDPAddr{b.LocalAddr()}, TypeRequest, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := testReceive(t, b); err != ErrSignature {
+		t.Errorf("expected %v, got %v", ErrSignature, err)
+	}
+}
+
+func TestSendRequiresKey(t *testing.T) {
+	a := testListen(t, func() []byte { return nil })
+	if _, err := a.Send([]*net.UDPAddr{a.LocalAddr()}, TypeRequest, 0, nil); err != ErrNoKey {
+		t.Errorf("expected %v, got %v", ErrNoKey, err)
+	}
+}
+
+func TestListenMulticastRequiresGroup(t *testing.T) {
+	if _, err := ListenMulticast("127.0.0.1:0", testKey, 10*time.Second); err == nil {
+		t.Error("expected an error for a unicast address")
+	}
+}
+
+func TestReceiveRejectsExpired(t *testing.T) {
+	a := testListen(t, testKey)
+	b := testListen(t, testKey)
+	for _, sent := range []time.Time{time.Now().Add(-time.Minute), time.Now().Add(time.Minute)} {
+		data := Seal(testKey(), &Packet{Type: TypeRequest, Session: 7, Seq: 1, Time: sent.UnixNano()})
+		if _, err := a.conn.WriteToUDP(data, b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := testReceive(t, b); err != ErrExpired {
+			t.Errorf("expected %v for a packet sent at %v, got %v", ErrExpired, sent, err)
+		}
+	}
+}
+
+func TestAcceptEvictsOldestSession(t *testing.T) {
+	c := &Conn{window: time.Minute, seen: map[uint64]received{}}
+	now := time.Now()
+	for session := uint64(1); session <= maxSessions; session++ {
+		at := now.Add(time.Duration(session) * time.Millisecond)
+		if err := c.accept(&Packet{Session: session, Seq: 1, Time: at.UnixNano()}, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	at := now.Add(time.Second)
+	if err := c.accept(&Packet{Session: maxSessions + 1, Seq: 1, Time: at.UnixNano()}, at); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.seen) != maxSessions {
+		t.Errorf("expected %d sessions, got %d", maxSessions, len(c.seen))
+	}
+	if _, ok := c.seen[1]; ok {
+		t.Error("expected the oldest session to be evicted")
+	}
+	// The other sessions are still protected against replays
+	if err := c.accept(&Packet{Session: 2, Seq: 1, Time: at.UnixNano()}, at); err != ErrReplay {
+		t.Errorf("expected %v, got %v", ErrReplay, err)
+	}
+}
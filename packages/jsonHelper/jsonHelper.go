@@ -67,6 +67,12 @@ func setField(field reflect.Value, value string) error {
 			return err
 		}
 		field.SetInt(i)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
 
 	default:
 		return fmt.Errorf("unable to set field of type %s", field.Kind())
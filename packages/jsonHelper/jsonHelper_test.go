@@ -7,6 +7,7 @@ type testStruct struct {
 	Bool   bool   `json:"bool"`
 	String string `json:"string"`
 	TypeNotSet float32 `json:"float"`
+	Float64 float64 `json:"float64"`
 }
 
 func TestSetStructFieldByTag(t *testing.T) {
@@ -98,6 +99,19 @@ func TestSetStructFieldByTag(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "testSetFloat64",
+			args: args{
+				tag:   "float64",
+				value: "0.54",
+				taggedStruct: &testStruct{
+					Int:    0,
+					Bool:   false,
+					String: "",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "testMissingType",
 			args: args{
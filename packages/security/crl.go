@@ -0,0 +1,182 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"github.com/syleron/pulseha/packages/utils"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// CRLName is the file our certificate revocation list is kept in.
+const CRLName = "ca.crl"
+
+// LoadCRL loads our certificate revocation list.
+// Returns nil when no certificate has been revoked.
+// Note: A revocation list issued by an external CA can be placed in CertDir as it is never replaced then.
+func LoadCRL() (*x509.RevocationList, error) {
+	data, err := ioutil.ReadFile(CertDir + CRLName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseCRL(data)
+}
+
+// parseCRL parses a PEM encoded certificate revocation list.
+func parseCRL(data []byte) (*x509.RevocationList, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "X509 CRL" {
+		return nil, errors.New("invalid certificate revocation list")
+	}
+	return x509.ParseRevocationList(block.Bytes)
+}
+
+// CRLNumber returns the number of our certificate revocation list or 0 when we don't have one.
+func CRLNumber() uint64 {
+	crl, err := LoadCRL()
+	if err != nil || crl == nil || crl.Number == nil {
+		return 0
+	}
+	return crl.Number.Uint64()
+}
+
+// loadRevoked loads the serial numbers of the certificates in our revocation list.
+func loadRevoked() (map[string]bool, error) {
+	crl, err := LoadCRL()
+	if err != nil {
+		return nil, errors.New("unable to load " + CRLName + ": " + err.Error())
+	}
+	revoked := map[string]bool{}
+	if crl != nil {
+		for _, entry := range crl.RevokedCertificateEntries {
+			revoked[entry.SerialNumber.Text(16)] = true
+		}
+	}
+	return revoked, nil
+}
+
+// Revoke adds a certificate to our revocation list and signs the list with the cluster CA.
+// Returns the revocation list so it can be sent to our members.
+func Revoke(serial string) ([]byte, error) {
+	if err := managed(); err != nil {
+		return nil, err
+	}
+	number, ok := new(big.Int).SetString(strings.ToLower(strings.ReplaceAll(serial, ":", "")), 16)
+	if !ok {
+		return nil, errors.New("invalid serial number " + serial)
+	}
+	crl, err := LoadCRL()
+	if err != nil {
+		return nil, err
+	}
+	entries := []x509.RevocationListEntry{}
+	if crl != nil {
+		entries = crl.RevokedCertificateEntries
+	}
+	for _, entry := range entries {
+		if entry.SerialNumber.Cmp(number) == 0 {
+			// Already revoked. Return the list so it can be sent again.
+			return ioutil.ReadFile(CertDir + CRLName)
+		}
+	}
+	entries = append(entries, x509.RevocationListEntry{
+		SerialNumber:   number,
+		RevocationTime: time.Now(),
+	})
+	return issueCRL(entries)
+}
+
+// issueCRL signs a new revocation list with the cluster CA and writes it to file.
+func issueCRL(entries []x509.RevocationListEntry) ([]byte, error) {
+	if err := managed(); err != nil {
+		return nil, err
+	}
+	if !HasCAKey() {
+		return nil, errors.New("unable to sign the revocation list as ca.key is missing")
+	}
+	caCert, caKey, err := loadCA()
+	if err != nil {
+		return nil, err
+	}
+	if caCert.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		return nil, errors.New("the cluster CA is not allowed to sign revocation lists. Please rotate the CA")
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    new(big.Int).SetUint64(CRLNumber() + 1),
+		ThisUpdate:                time.Now(),
+		NextUpdate:                time.Now().Add(CAValidity),
+	}, caCert, caKey)
+	if err != nil {
+		return nil, err
+	}
+	crlPEM := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+	if err := utils.WriteFileAtomic(CertDir+CRLName, crlPEM, 0644); err != nil {
+		return nil, err
+	}
+	return crlPEM, nil
+}
+
+// InstallCRL replaces our revocation list with one signed by a CA we trust.
+// A revocation list older than ours is ignored.
+func InstallCRL(data []byte) error {
+	if err := managed(); err != nil {
+		return err
+	}
+	crl, err := parseCRL(data)
+	if err != nil {
+		return err
+	}
+	cas, err := loadCACerts()
+	if err != nil {
+		return err
+	}
+	trusted := false
+	for _, ca := range cas {
+		if crl.CheckSignatureFrom(ca) == nil {
+			trusted = true
+			break
+		}
+	}
+	if !trusted {
+		return errors.New("revocation list is not signed by a trusted CA")
+	}
+	if current, err := LoadCRL(); err == nil && current != nil && current.Number.Cmp(crl.Number) > 0 {
+		return nil
+	}
+	return utils.WriteFileAtomic(CertDir+CRLName, data, 0644)
+}
+
+// ReissueCRL signs our revocation list again with the CA ca.key belongs to.
+// Returns nil when no certificate has been revoked.
+func ReissueCRL() ([]byte, error) {
+	crl, err := LoadCRL()
+	if err != nil || crl == nil {
+		return nil, err
+	}
+	return issueCRL(crl.RevokedCertificateEntries)
+}
@@ -0,0 +1,85 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"testing"
+)
+
+func TestRevoke(t *testing.T) {
+	setupTestCerts(t)
+	cert, _, err := LocalCertificates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, _ := ClientTLSConfig("node1")
+	if _, err := handshake(t, config); err != nil {
+		t.Fatalf("expected our certificate to be accepted before it is revoked: %s", err)
+	}
+	if _, err := Revoke(cert.Serial); err != nil {
+		t.Fatal(err)
+	}
+	if CRLNumber() != 1 {
+		t.Errorf("expected revocation list 1, got %d", CRLNumber())
+	}
+	config, _ = ClientTLSConfig("node1")
+	if _, err := handshake(t, config); err == nil {
+		t.Error("expected a revoked certificate to be rejected")
+	}
+	// Revoking a certificate twice doesn't issue a new revocation list
+	if _, err := Revoke(cert.Serial); err != nil {
+		t.Fatal(err)
+	}
+	if CRLNumber() != 1 {
+		t.Errorf("expected revocation list 1, got %d", CRLNumber())
+	}
+	if _, err := Revoke("not a serial"); err == nil {
+		t.Error("expected an invalid serial number to be rejected")
+	}
+}
+
+func TestInstallCRL(t *testing.T) {
+	setupTestCerts(t)
+	older, err := Revoke("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Revoke("2"); err != nil {
+		t.Fatal(err)
+	}
+	// An older revocation list is ignored
+	if err := InstallCRL(older); err != nil {
+		t.Fatal(err)
+	}
+	if CRLNumber() != 2 {
+		t.Errorf("expected revocation list 2 to be kept, got %d", CRLNumber())
+	}
+	// A revocation list signed by another CA is rejected
+	certDir := CertDir
+	CertDir = t.TempDir() + "/"
+	if err := GenerateCACert("127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	other, err := Revoke("3")
+	CertDir = certDir
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := InstallCRL(other); err == nil {
+		t.Error("expected a revocation list signed by another CA to be rejected")
+	}
+}
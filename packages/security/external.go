@@ -0,0 +1,103 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrExternalCA is returned when asked to generate or distribute keys while our certificates are issued by an external CA.
+var ErrExternalCA = errors.New("certificates are issued by an external CA")
+
+// ExternalCA locates our certificate, key and the CA bundle our members are verified against
+// when they are issued by a CA outside of PulseHA.
+type ExternalCA struct {
+	Cert string
+	Key  string
+	CA   string
+}
+
+var (
+	externalCA *ExternalCA
+	externalMu sync.RWMutex
+)
+
+// NewExternalCA creates an external CA from its config values.
+func NewExternalCA(cert string, key string, ca string) (*ExternalCA, error) {
+	if cert == "" || key == "" || ca == "" {
+		return nil, errors.New("the tls_cert_file, tls_key_file and tls_ca_file values are required by tls_external_ca")
+	}
+	return &ExternalCA{
+		Cert: cert,
+		Key:  key,
+		CA:   ca,
+	}, nil
+}
+
+// SetExternalCA sets the files issued by an external CA we use from now on.
+// Our own CA in CertDir is used when nil.
+func SetExternalCA(e *ExternalCA) {
+	externalMu.Lock()
+	defer externalMu.Unlock()
+	externalCA = e
+}
+
+// External returns whether our certificates are issued by an external CA.
+func External() bool {
+	return currentExternalCA() != nil
+}
+
+// currentExternalCA returns the files issued by an external CA or nil when we use our own CA.
+func currentExternalCA() *ExternalCA {
+	externalMu.RLock()
+	defer externalMu.RUnlock()
+	return externalCA
+}
+
+// managed returns an error when our certificates are issued by an external CA
+// as we must never generate or distribute keys in that case.
+func managed() error {
+	if External() {
+		return ErrExternalCA
+	}
+	return nil
+}
+
+// certFile returns the file our certificate is loaded from.
+func certFile() string {
+	if e := currentExternalCA(); e != nil {
+		return e.Cert
+	}
+	return CertDir + CertName + ".crt"
+}
+
+// keyFile returns the file our key is loaded from.
+func keyFile() string {
+	if e := currentExternalCA(); e != nil {
+		return e.Key
+	}
+	return CertDir + CertName + ".key"
+}
+
+// caFile returns the file the CAs we trust are loaded from.
+func caFile() string {
+	if e := currentExternalCA(); e != nil {
+		return e.CA
+	}
+	return CertDir + "ca.crt"
+}
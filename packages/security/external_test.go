@@ -0,0 +1,119 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"crypto/x509"
+	"github.com/syleron/pulseha/packages/utils"
+	"testing"
+)
+
+// setupTestExternalCA issues a certificate for node1 from an external root and intermediate CA in a temporary folder.
+// Our own cluster CA is kept in CertDir to make sure it isn't used.
+func setupTestExternalCA(t *testing.T) {
+	setupTestCerts(t)
+	dir := t.TempDir() + "/"
+	rootKey, rootPEM, err := createCA("127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := parseCACerts(rootPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediateKey, err := generateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := certTemplate(CAValidity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.IsCA = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign
+	tmpl.Subject.CommonName = "Intermediate CA"
+	intermediate, intermediatePEM, err := createCert(tmpl, root[0], intermediateKey.Public(), rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := generateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, err := issueCert("127.0.0.1", "node1", key.Public(), intermediate, intermediateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Our certificate is sent along with the intermediate CA that signed it
+	for file, data := range map[string][]byte{
+		"node1.pem":     append(certPEM, intermediatePEM...),
+		"node1-key.pem": keyPEM,
+		"root.pem":      rootPEM,
+	} {
+		if err := utils.WriteFileAtomic(dir+file, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	SetExternalCA(&ExternalCA{
+		Cert: dir + "node1.pem",
+		Key:  dir + "node1-key.pem",
+		CA:   dir + "root.pem",
+	})
+	t.Cleanup(func() {
+		SetExternalCA(nil)
+	})
+}
+
+func TestExternalCA(t *testing.T) {
+	setupTestExternalCA(t)
+	if err := CheckPeerCert("node1"); err != nil {
+		t.Errorf("expected our certificate to be valid for node1: %s", err)
+	}
+	config, err := ClientTLSConfig("node1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity, err := handshake(t, config); err != nil || identity != "node1" {
+		t.Errorf("expected a mutual TLS connection as node1, got %q: %v", identity, err)
+	}
+	// Keys are never generated or distributed
+	if HasCAKey() {
+		t.Error("expected the CA key to be ignored")
+	}
+	if _, _, err := GenerateCSR("node1"); err != ErrExternalCA {
+		t.Errorf("expected no certificate signing request to be generated, got %v", err)
+	}
+	if err := GenTLSKeys("127.0.0.1", "node1"); err != ErrExternalCA {
+		t.Errorf("expected no certificate to be generated, got %v", err)
+	}
+	if _, err := Revoke("1"); err != ErrExternalCA {
+		t.Errorf("expected no certificate to be revoked, got %v", err)
+	}
+}
+
+func TestNewExternalCA(t *testing.T) {
+	if _, err := NewExternalCA("node1.pem", "node1-key.pem", "root.pem"); err != nil {
+		t.Error(err)
+	}
+	if _, err := NewExternalCA("node1.pem", "", "root.pem"); err == nil {
+		t.Error("expected an external CA without a key to be rejected")
+	}
+}
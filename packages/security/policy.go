@@ -0,0 +1,224 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// The algorithms our keys can be generated with.
+const (
+	KeyRSA2048   = "rsa-2048"
+	KeyRSA3072   = "rsa-3072"
+	KeyRSA4096   = "rsa-4096"
+	KeyECDSAP256 = "ecdsa-p256"
+	KeyECDSAP384 = "ecdsa-p384"
+	KeyEd25519   = "ed25519"
+)
+
+// DefaultKeyAlgorithm is the algorithm our keys are generated with unless another is configured.
+// Note: This matches the keys of older versions of PulseHA so existing cluster configs keep working.
+const DefaultKeyAlgorithm = KeyRSA2048
+
+// KeyAlgorithms lists every algorithm our keys can be generated with.
+var KeyAlgorithms = []string{KeyRSA2048, KeyRSA3072, KeyRSA4096, KeyECDSAP256, KeyECDSAP384, KeyEd25519}
+
+// The TLS versions our server and client can be limited to.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// Policy defines the algorithm of the keys we generate and the TLS parameters we accept.
+type Policy struct {
+	KeyAlgorithm string
+	// The minimum TLS version our server and client accept
+	MinVersion uint16
+	// The TLS 1.2 cipher suites our server and client accept. Go's secure defaults are used when empty.
+	// Note: TLS 1.3 cipher suites are not configurable.
+	CipherSuites []uint16
+}
+
+var (
+	policy = Policy{
+		KeyAlgorithm: DefaultKeyAlgorithm,
+		MinVersion:   tls.VersionTLS12,
+	}
+	policyMu sync.RWMutex
+)
+
+// NewPolicy creates a policy from its config values.
+// Empty values use our defaults and cipher suites must be one of Go's secure cipher suites.
+func NewPolicy(keyAlgorithm string, minVersion string, cipherSuites []string) (Policy, error) {
+	p := Policy{
+		KeyAlgorithm: DefaultKeyAlgorithm,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if keyAlgorithm != "" {
+		valid := false
+		for _, algorithm := range KeyAlgorithms {
+			valid = valid || algorithm == keyAlgorithm
+		}
+		if !valid {
+			return Policy{}, errors.New("the key_algorithm value must be one of " + strings.Join(KeyAlgorithms, ", "))
+		}
+		p.KeyAlgorithm = keyAlgorithm
+	}
+	if minVersion != "" {
+		version, ok := tlsVersions[minVersion]
+		if !ok {
+			return Policy{}, errors.New("the tls_min_version value must be either 1.2 or 1.3")
+		}
+		p.MinVersion = version
+	}
+	for _, name := range cipherSuites {
+		id, ok := secureCipherSuite(name)
+		if !ok {
+			return Policy{}, errors.New("the tls_cipher_suites value " + name + " is not a supported cipher suite")
+		}
+		p.CipherSuites = append(p.CipherSuites, id)
+	}
+	return p, nil
+}
+
+// secureCipherSuite returns the id of a cipher suite Go considers secure.
+func secureCipherSuite(name string) (uint16, bool) {
+	for _, suite := range tls.CipherSuites() {
+		if suite.Name == name {
+			return suite.ID, true
+		}
+	}
+	return 0, false
+}
+
+// SetPolicy sets the key algorithm and TLS parameters used from now on.
+// Note: Our server applies the TLS parameters to new connections without a reload.
+func SetPolicy(p Policy) {
+	policyMu.Lock()
+	defer policyMu.Unlock()
+	policy = p
+}
+
+// currentPolicy returns the key algorithm and TLS parameters in use.
+func currentPolicy() Policy {
+	policyMu.RLock()
+	defer policyMu.RUnlock()
+	return policy
+}
+
+// applyPolicy sets the TLS parameters of our policy on a TLS config.
+func applyPolicy(config *tls.Config) *tls.Config {
+	p := currentPolicy()
+	config.MinVersion = p.MinVersion
+	config.CipherSuites = p.CipherSuites
+	return config
+}
+
+// generateKey generates a new key with the algorithm of our policy.
+func generateKey() (crypto.Signer, error) {
+	var key crypto.Signer
+	var err error
+	switch currentPolicy().KeyAlgorithm {
+	case KeyRSA3072:
+		key, err = rsa.GenerateKey(rand.Reader, 3072)
+	case KeyRSA4096:
+		key, err = rsa.GenerateKey(rand.Reader, 4096)
+	case KeyECDSAP256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyECDSAP384:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyEd25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	if err != nil {
+		return nil, errors.New("generating random key: " + err.Error())
+	}
+	return key, nil
+}
+
+// ParsePrivateKey parses a PEM encoded private key in PKCS1, PKCS8 or SEC1 form.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.New("unsupported private key encoding")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key type")
+	}
+	return signer, nil
+}
+
+// encodePrivateKey PEM encodes a private key in PKCS8 form.
+func encodePrivateKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// publicKeysEqual returns whether two public keys are the same.
+func publicKeysEqual(a crypto.PublicKey, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
+}
+
+// signatureAlgorithm returns the algorithm signMessage uses for a key.
+func signatureAlgorithm(pub crypto.PublicKey) x509.SignatureAlgorithm {
+	switch pub.(type) {
+	case *ecdsa.PublicKey:
+		return x509.ECDSAWithSHA256
+	case ed25519.PublicKey:
+		return x509.PureEd25519
+	default:
+		return x509.SHA256WithRSA
+	}
+}
+
+// signMessage signs a message with a key so it can be checked with x509.Certificate.CheckSignature.
+func signMessage(key crypto.Signer, message []byte) ([]byte, error) {
+	if _, ok := key.(ed25519.PrivateKey); ok {
+		return key.Sign(rand.Reader, message, crypto.Hash(0))
+	}
+	digest := sha256.Sum256(message)
+	return key.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
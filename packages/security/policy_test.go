@@ -0,0 +1,141 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+)
+
+// setupTestPolicy uses a policy for the duration of a test.
+func setupTestPolicy(t *testing.T, p Policy) {
+	current := currentPolicy()
+	SetPolicy(p)
+	t.Cleanup(func() {
+		SetPolicy(current)
+	})
+}
+
+func TestNewPolicy(t *testing.T) {
+	p, err := NewPolicy("", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Keys default to RSA so older members can still use them
+	if p.KeyAlgorithm != KeyRSA2048 || p.MinVersion != tls.VersionTLS12 || len(p.CipherSuites) != 0 {
+		t.Errorf("unexpected default policy %+v", p)
+	}
+	p, err = NewPolicy(KeyEd25519, "1.3", []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.KeyAlgorithm != KeyEd25519 || p.MinVersion != tls.VersionTLS13 || len(p.CipherSuites) != 1 ||
+		p.CipherSuites[0] != tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 {
+		t.Errorf("unexpected policy %+v", p)
+	}
+	if _, err := NewPolicy("dsa-1024", "", nil); err == nil {
+		t.Error("expected an unknown key algorithm to be rejected")
+	}
+	if _, err := NewPolicy("", "1.1", nil); err == nil {
+		t.Error("expected TLS 1.1 to be rejected")
+	}
+	if _, err := NewPolicy("", "", []string{"TLS_RSA_WITH_RC4_128_SHA"}); err == nil {
+		t.Error("expected an insecure cipher suite to be rejected")
+	}
+}
+
+func TestKeyAlgorithms(t *testing.T) {
+	for _, algorithm := range KeyAlgorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			setupTestPolicy(t, Policy{KeyAlgorithm: algorithm, MinVersion: tls.VersionTLS12})
+			setupTestCerts(t)
+			config, err := ClientTLSConfig("node1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if identity, err := handshake(t, config); err != nil || identity != "node1" {
+				t.Errorf("expected a mutual TLS connection as node1, got %q: %v", identity, err)
+			}
+			// The CA key signs CA bundles and revocation lists
+			bundle, _, err := NewCA("127.0.0.1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			signature, err := SignTrustBundle(bundle)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := InstallTrustBundle(bundle, signature); err != nil {
+				t.Error(err)
+			}
+			if _, err := Revoke("1"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sec1, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, block := range map[string]*pem.Block{
+		"PKCS1": {Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)},
+		"SEC1":  {Type: "EC PRIVATE KEY", Bytes: sec1},
+		"PKCS8": {Type: "PRIVATE KEY", Bytes: pkcs8},
+	} {
+		if _, err := ParsePrivateKey(pem.EncodeToMemory(block)); err != nil {
+			t.Errorf("expected a %s key to be parsed: %s", name, err)
+		}
+	}
+	if _, err := ParsePrivateKey([]byte("invalid")); err == nil {
+		t.Error("expected an invalid key to be rejected")
+	}
+}
+
+func TestMinVersion(t *testing.T) {
+	setupTestCerts(t)
+	setupTestPolicy(t, Policy{KeyAlgorithm: DefaultKeyAlgorithm, MinVersion: tls.VersionTLS13})
+	config, _ := ClientTLSConfig("node1")
+	if _, err := handshake(t, config); err != nil {
+		t.Errorf("expected a TLS 1.3 connection to be accepted: %s", err)
+	}
+	config.MinVersion = tls.VersionTLS12
+	config.MaxVersion = tls.VersionTLS12
+	if _, err := handshake(t, config); err == nil {
+		t.Error("expected a TLS 1.2 connection to be rejected")
+	}
+}
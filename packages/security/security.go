@@ -19,8 +19,8 @@
 package security
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
@@ -30,16 +30,32 @@ import (
 	"github.com/syleron/pulseha/packages/utils"
 	"math/big"
 	"net"
-	"os"
 	"time"
 )
 
+// CertDir is the folder our TLS certificates are kept in.
+// Note: Must end with a slash.
+var CertDir = "/etc/pulseha/certs/"
+
 const (
-	CertDir  = "/etc/pulseha/certs/"
 	CertName = "pulseha"
 )
 
-func GenTLSKeys(ip string) error {
+var (
+	// CAValidity is how long a cluster CA is valid for.
+	CAValidity = 10 * 365 * 24 * time.Hour
+	// CertValidity is how long a member certificate is valid for.
+	CertValidity = 730 * 24 * time.Hour
+	// RenewBefore is how long before it expires a member certificate is renewed.
+	RenewBefore = 30 * 24 * time.Hour
+)
+
+// GenTLSKeys generates our certificate signed by the cluster CA.
+// The certificate is issued to our hostname which identifies us to our peers.
+func GenTLSKeys(ip string, hostname string) error {
+	if err := managed(); err != nil {
+		return err
+	}
 	// Make sure we have the cert directory
 	utils.CreateFolder(CertDir)
 	// Log our action
@@ -51,94 +67,311 @@ func GenTLSKeys(ip string) error {
 		return errors.New("unable to generate TLS keys as ca.crt/ca.key are missing")
 	}
 	// Load the CA
-	caCert, err := utils.LoadFile(CertDir + "ca.crt")
+	cert, key, err := loadCA()
 	if err != nil {
 		log.Error(err.Error())
-		return errors.New(err.Error())
+		return err
+	}
+	// Generate our certificate
+	return GenerateCerts(ip, hostname, cert, key)
+}
+
+// HasCAKey returns whether we hold the cluster CA key and are able to sign member certificates.
+// Note: We never sign certificates when they are issued by an external CA.
+func HasCAKey() bool {
+	return !External() && utils.CheckFileExists(CertDir+"ca.key")
+}
+
+// loadCA loads the cluster CA certificate and key.
+// Note: While the CA is rotated ca.crt holds more than one CA.
+func loadCA() (*x509.Certificate, crypto.Signer, error) {
+	cas, err := loadCACerts()
+	if err != nil {
+		return nil, nil, err
 	}
 	caKey, err := utils.LoadFile(CertDir + "ca.key")
 	if err != nil {
-		log.Error(err.Error())
-		return errors.New(err.Error())
-	}
-	// Make sure we have values for both caCert and caKey
-	if len(caKey) == 0 || len(caCert) == 0 {
-		return errors.New("invalid ca.cert ca.key values")
-	}
-	// Decode the cert and key
-	cpb, _ := pem.Decode(caCert)
-	kpb, _ := pem.Decode(caKey)
-	//// Parse the cert
-	cert, e := x509.ParseCertificate(cpb.Bytes)
-	if e != nil {
-		fmt.Println("parsex509:", e.Error())
-		os.Exit(1)
-	}
-	// Parse the key
-	key, e := x509.ParsePKCS1PrivateKey(kpb.Bytes)
-	if e != nil {
-		fmt.Println("parsekey:", e.Error())
-		os.Exit(1)
+		return nil, nil, err
 	}
-	// Generate our certificate
-	GenerateCerts(ip, cert, key)
-	return nil
+	key, err := ParsePrivateKey(caKey)
+	if err != nil {
+		return nil, nil, errors.New("unable to parse ca.key: " + err.Error())
+	}
+	cert, err := caOf(cas, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// caOf returns the CA certificate a CA key belongs to.
+func caOf(cas []*x509.Certificate, key crypto.Signer) (*x509.Certificate, error) {
+	for _, ca := range cas {
+		if publicKeysEqual(key.Public(), ca.PublicKey) {
+			return ca, nil
+		}
+	}
+	return nil, errors.New("ca.key does not belong to ca.crt")
+}
+
+// loadCACerts loads the CA certificates we trust.
+func loadCACerts() ([]*x509.Certificate, error) {
+	data, err := utils.LoadFile(caFile())
+	if err != nil {
+		return nil, err
+	}
+	return parseCACerts(data)
+}
+
+// parseCACerts parses a bundle of PEM encoded CA certificates.
+func parseCACerts(data []byte) ([]*x509.Certificate, error) {
+	cas := []*x509.Certificate{}
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.New("unable to parse ca.crt: " + err.Error())
+		}
+		if !cert.IsCA {
+			return nil, errors.New("ca.crt contains a certificate that isn't a CA")
+		}
+		cas = append(cas, cert)
+	}
+	if len(cas) == 0 {
+		return nil, errors.New("invalid ca.crt value")
+	}
+	return cas, nil
 }
 
-func GenerateCACert(ip string) {
+// GenerateCSR generates a new key and a certificate signing request for our hostname.
+// The key is returned rather than written so our current certificate stays in place until the request is signed.
+func GenerateCSR(hostname string) ([]byte, crypto.Signer, error) {
+	if err := managed(); err != nil {
+		return nil, nil, err
+	}
+	key, err := generateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	csrTmpl := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			Organization: []string{"PulseHA"},
+			CommonName:   hostname,
+		},
+		DNSNames: []string{hostname},
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, csrTmpl, key)
+	if err != nil {
+		return nil, nil, errors.New("creating certificate signing request: " + err.Error())
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}), key, nil
+}
+
+// SignCSR signs a member's certificate signing request with the cluster CA.
+// Only the public key is taken from the request; the certificate is issued to the given hostname and IP.
+func SignCSR(csrPEM []byte, ip string, hostname string) ([]byte, error) {
+	if err := managed(); err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("invalid certificate signing request")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, errors.New("unable to parse certificate signing request: " + err.Error())
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, errors.New("invalid certificate signing request signature: " + err.Error())
+	}
+	if csr.Subject.CommonName != hostname {
+		return nil, fmt.Errorf("certificate signing request for %s does not match %s", csr.Subject.CommonName, hostname)
+	}
+	if !HasCAKey() {
+		return nil, errors.New("unable to sign certificate as ca.key is missing")
+	}
+	caCert, caKey, err := loadCA()
+	if err != nil {
+		return nil, err
+	}
+	return issueCert(ip, hostname, csr.PublicKey, caCert, caKey)
+}
+
+// GenerateCACert generates a new cluster CA.
+func GenerateCACert(ip string) error {
+	if err := managed(); err != nil {
+		return err
+	}
 	utils.CreateFolder(CertDir)
+	rootKey, rootCertPEM, err := createCA(ip)
+	if err != nil {
+		return err
+	}
+	// write keys
+	return WriteCertAndKeyFiles("ca", rootCertPEM, rootKey)
+}
+
+// createCA generates a new cluster CA.
+func createCA(ip string) (crypto.Signer, []byte, error) {
 	// Generate new key pair
-	rootKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	rootKey, err := generateKey()
 	if err != nil {
-		log.Fatalf("generating random key: %v", err)
+		return nil, nil, err
 	}
 	// Generate Cert Template
-	rootCertTmpl, err := certTemplate()
+	rootCertTmpl, err := certTemplate(CAValidity)
 	if err != nil {
-		log.Fatalf("creating cert template: %v", err)
+		return nil, nil, fmt.Errorf("creating cert template: %v", err)
 	}
 	// Populate cert template
 	rootCertTmpl.IsCA = true
-	rootCertTmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	rootCertTmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
 	rootCertTmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
 	rootCertTmpl.IPAddresses = []net.IP{net.ParseIP(ip)}
+	rootCertTmpl.Subject.CommonName = "PulseHA CA"
 	// Generate cert from template and sign
-	_, rootCertPEM, err := createCert(rootCertTmpl, rootCertTmpl, &rootKey.PublicKey, rootKey)
+	_, rootCertPEM, err := createCert(rootCertTmpl, rootCertTmpl, rootKey.Public(), rootKey)
 	if err != nil {
-		log.Fatalf("error creating cert: %v", err)
+		return nil, nil, fmt.Errorf("error creating cert: %v", err)
 	}
-	// write keys
-	WriteCertFile("ca", rootCertPEM)
-	WriteKeyFileFromRSAKey("ca", rootKey)
+	return rootKey, rootCertPEM, nil
+}
+
+// NewCA generates a new cluster CA to rotate to.
+// Nothing is written so the new CA can be trusted by every member before it is used.
+// Returns the new CA certificate followed by the CAs we currently trust, and the new CA key.
+func NewCA(ip string) ([]byte, []byte, error) {
+	if !HasCAKey() {
+		return nil, nil, errors.New("unable to rotate the CA as ca.key is missing")
+	}
+	current, err := utils.LoadFile(CertDir + "ca.crt")
+	if err != nil {
+		return nil, nil, err
+	}
+	rootKey, rootCertPEM, err := createCA(ip)
+	if err != nil {
+		return nil, nil, err
+	}
+	rootKeyPEM, err := encodePrivateKey(rootKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(rootCertPEM, current...), rootKeyPEM, nil
+}
+
+// RetireCAs stops trusting every CA but the one ca.key belongs to.
+// Returns the new CA certificate bundle.
+func RetireCAs() ([]byte, error) {
+	if err := managed(); err != nil {
+		return nil, err
+	}
+	caCert, _, err := loadCA()
+	if err != nil {
+		return nil, err
+	}
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw})
+	if err := utils.WriteFileAtomic(CertDir+"ca.crt", bundle, 0644); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// SignTrustBundle signs a CA certificate bundle with our CA key so members can verify it came from a CA they trust.
+func SignTrustBundle(bundle []byte) ([]byte, error) {
+	_, key, err := loadCA()
+	if err != nil {
+		return nil, err
+	}
+	return signMessage(key, bundle)
+}
+
+// InstallTrustBundle replaces the CA certificates we trust with a bundle signed by one of the CAs we currently trust.
+func InstallTrustBundle(bundle []byte, signature []byte) error {
+	if err := managed(); err != nil {
+		return err
+	}
+	if _, err := parseCACerts(bundle); err != nil {
+		return err
+	}
+	current, err := loadCACerts()
+	if err != nil {
+		return err
+	}
+	for _, ca := range current {
+		if ca.CheckSignature(signatureAlgorithm(ca.PublicKey), bundle, signature) == nil {
+			return utils.WriteFileAtomic(CertDir+"ca.crt", bundle, 0644)
+		}
+	}
+	return errors.New("CA certificates are not signed by a trusted CA")
+}
+
+// InstallCAKey replaces our CA key with the key of one of the CAs we trust.
+// Note: Only members that already hold a CA key accept a new one.
+func InstallCAKey(keyPEM []byte) error {
+	if err := managed(); err != nil {
+		return err
+	}
+	if !HasCAKey() {
+		return errors.New("unable to install the CA key as we don't hold one")
+	}
+	key, err := ParsePrivateKey(keyPEM)
+	if err != nil {
+		return errors.New("unable to parse ca.key: " + err.Error())
+	}
+	cas, err := loadCACerts()
+	if err != nil {
+		return err
+	}
+	if _, err := caOf(cas, key); err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(CertDir+"ca.key", keyPEM, 0600)
+}
+
+// CAKey returns our PEM encoded CA key.
+func CAKey() ([]byte, error) {
+	return utils.LoadFile(CertDir + "ca.key")
 }
 
-func GenerateCerts(ip string, caCert *x509.Certificate, caKey *rsa.PrivateKey) {
+// GenerateCerts generates a new key and a certificate for our hostname signed by the given CA.
+func GenerateCerts(ip string, hostname string, caCert *x509.Certificate, caKey crypto.Signer) error {
+	if err := managed(); err != nil {
+		return err
+	}
 	utils.CreateFolder(CertDir)
 	// Generate new key pair
-	servKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	servKey, err := generateKey()
 	if err != nil {
-		log.Fatalf("generating random key: %v", err)
+		return err
 	}
+	// Generate cert and sign
+	servCertPEM, err := issueCert(ip, hostname, servKey.Public(), caCert, caKey)
+	if err != nil {
+		return fmt.Errorf("error creating cert: %v", err)
+	}
+	// write keys
+	return WriteCertAndKeyFiles(CertName, servCertPEM, servKey)
+}
+
+// issueCert creates a member certificate for the given public key signed by the cluster CA.
+func issueCert(ip string, hostname string, pub crypto.PublicKey, caCert *x509.Certificate, caKey crypto.Signer) ([]byte, error) {
 	// Generate Cert template
-	servCertTmpl, err := certTemplate()
+	servCertTmpl, err := certTemplate(CertValidity)
 	if err != nil {
-		log.Fatalf("creating cert template: %v", err)
+		return nil, err
 	}
 	// Populate cert template
 	servCertTmpl.KeyUsage = x509.KeyUsageDigitalSignature
-	servCertTmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	// Our certificate is used for both our server and our connections to other members
+	servCertTmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
 	servCertTmpl.IPAddresses = []net.IP{net.ParseIP(ip)}
+	servCertTmpl.Subject.CommonName = hostname
+	servCertTmpl.DNSNames = []string{hostname}
 	// Generate cert from template and sign
-	_, servCertPEM, err := createCert(servCertTmpl, caCert, &servKey.PublicKey, caKey)
-	if err != nil {
-		log.Fatalf("error creating cert: %v", err)
-	}
-	// write keys
-	WriteCertFile(CertName, servCertPEM)
-	WriteKeyFileFromRSAKey(CertName, servKey)
+	_, servCertPEM, err := createCert(servCertTmpl, caCert, pub, caKey)
+	return servCertPEM, err
 }
 
-func certTemplate() (*x509.Certificate, error) {
+func certTemplate(validity time.Duration) (*x509.Certificate, error) {
 	// generate a random serial number
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
@@ -151,7 +384,7 @@ func certTemplate() (*x509.Certificate, error) {
 			Organization: []string{"PulseHA"},
 		},
 		NotBefore:             time.Now(),
-		NotAfter:              time.Now().Add(time.Duration(730) * time.Hour * 24),
+		NotAfter:              time.Now().Add(validity),
 		BasicConstraintsValid: true,
 	}
 	return &tmpl, nil
@@ -173,35 +406,43 @@ func createCert(template, parent *x509.Certificate, pub interface{}, parentPriv
 	return
 }
 
-func WriteCertFile(fileName string, cert []byte) {
-	// Write the cert to file
-	certOut, err := os.Create(CertDir + "/" + fileName + ".crt")
-	if err != nil {
-		fmt.Println("Failed writing key:", err)
-		os.Exit(1)
+// WriteCertFile writes a PEM encoded certificate to our cert directory.
+func WriteCertFile(fileName string, cert []byte) error {
+	if err := managed(); err != nil {
+		return err
+	}
+	if err := utils.WriteFileAtomic(CertDir+fileName+".crt", cert, 0644); err != nil {
+		return errors.New("failed writing " + fileName + ".crt: " + err.Error())
 	}
-	certOut.Write(cert)
-	certOut.Close()
+	return nil
 }
 
-func WriteKeyFile(fileName string, key []byte) {
-	// Write the key to file
-	keyOut, err := os.OpenFile(CertDir+fileName+".key", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		fmt.Println("Failed writing key:", err)
-		os.Exit(1)
+// WriteKeyFile writes a PEM encoded key to our cert directory.
+func WriteKeyFile(fileName string, key []byte) error {
+	if err := managed(); err != nil {
+		return err
 	}
-	keyOut.Write(key)
-	keyOut.Close()
+	if err := utils.WriteFileAtomic(CertDir+fileName+".key", key, 0600); err != nil {
+		return errors.New("failed writing " + fileName + ".key: " + err.Error())
+	}
+	return nil
 }
 
-func WriteKeyFileFromRSAKey(filename string, key *rsa.PrivateKey) {
-	// Write the key to file
-	keyOut, err := os.OpenFile(CertDir+"/"+filename+".key", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+// WriteCertAndKeyFiles writes a PEM encoded certificate and its key to our cert directory.
+// Note: Neither file is replaced unless both were written so the certificate always matches the key.
+func WriteCertAndKeyFiles(fileName string, cert []byte, key crypto.Signer) error {
+	if err := managed(); err != nil {
+		return err
+	}
+	keyPEM, err := encodePrivateKey(key)
 	if err != nil {
-		fmt.Println("Failed writing key:", err)
-		os.Exit(1)
+		return err
+	}
+	if err := utils.WriteFilesAtomic(
+		utils.AtomicFile{Name: CertDir + fileName + ".crt", Data: cert, Perm: 0644},
+		utils.AtomicFile{Name: CertDir + fileName + ".key", Data: keyPEM, Perm: 0600},
+	); err != nil {
+		return errors.New("failed writing " + fileName + ".crt and " + fileName + ".key: " + err.Error())
 	}
-	pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
-	keyOut.Close()
+	return nil
 }
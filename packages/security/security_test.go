@@ -0,0 +1,138 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestSignCSR(t *testing.T) {
+	setupTestCerts(t)
+	csr, key, err := GenerateCSR("node2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	crt, err := SignCSR(csr, "10.0.0.2", "node2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(crt)
+	if block == nil {
+		t.Fatal("expected a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity, err := PeerIdentity(cert); err != nil || identity != "node2" {
+		t.Errorf("expected the certificate to be issued to node2, got %q", identity)
+	}
+	if len(cert.IPAddresses) != 1 || cert.IPAddresses[0].String() != "10.0.0.2" {
+		t.Errorf("expected the certificate to be issued to 10.0.0.2, got %v", cert.IPAddresses)
+	}
+	if !publicKeysEqual(key.Public(), cert.PublicKey) {
+		t.Error("expected the certificate to be issued for the requested key")
+	}
+	caCert, err := ioutil.ReadFile(CertDir + "ca.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(caCert)
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		DNSName:   "node2",
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		t.Errorf("expected the certificate to be signed by the cluster CA: %s", err)
+	}
+}
+
+func TestSignCSRRejectsOtherHostname(t *testing.T) {
+	setupTestCerts(t)
+	csr, _, err := GenerateCSR("node2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SignCSR(csr, "10.0.0.3", "node3"); err == nil {
+		t.Error("expected a request for node2 to be rejected for node3")
+	}
+	if _, err := SignCSR([]byte("invalid"), "10.0.0.2", "node2"); err == nil {
+		t.Error("expected an invalid request to be rejected")
+	}
+}
+
+func TestSignCSRWithoutCAKey(t *testing.T) {
+	setupTestCerts(t)
+	if err := os.Remove(CertDir + "ca.key"); err != nil {
+		t.Fatal(err)
+	}
+	if HasCAKey() {
+		t.Error("expected the CA key to be missing")
+	}
+	csr, _, err := GenerateCSR("node2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SignCSR(csr, "10.0.0.2", "node2"); err == nil {
+		t.Error("expected signing without the CA key to fail")
+	}
+}
+
+func TestRotateCA(t *testing.T) {
+	setupTestCerts(t)
+	bundle, key, err := NewCA("127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := SignTrustBundle(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := InstallTrustBundle(bundle, []byte("invalid")); err == nil {
+		t.Error("expected a CA bundle without a trusted signature to be rejected")
+	}
+	if err := InstallTrustBundle(bundle, signature); err != nil {
+		t.Fatal(err)
+	}
+	if _, cas, _ := LocalCertificates(); len(cas) != 2 {
+		t.Fatalf("expected both CAs to be trusted, got %d", len(cas))
+	}
+	// Our current certificate is still trusted while the CA is rotated
+	if err := CheckPeerCert("node1"); err != nil {
+		t.Errorf("expected our certificate to be valid during the rotation: %s", err)
+	}
+	if err := InstallCAKey(key); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenTLSKeys("127.0.0.1", "node1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RetireCAs(); err != nil {
+		t.Fatal(err)
+	}
+	if _, cas, _ := LocalCertificates(); len(cas) != 1 {
+		t.Fatalf("expected only the new CA to be trusted, got %d", len(cas))
+	}
+	if err := CheckPeerCert("node1"); err != nil {
+		t.Errorf("expected our renewed certificate to be signed by the new CA: %s", err)
+	}
+}
@@ -0,0 +1,263 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// certStore holds the certificate, CAs and revocation list our server uses so they can be reloaded without a restart.
+type certStore struct {
+	cert    tls.Certificate
+	pool    *x509.CertPool
+	revoked map[string]bool
+	// The modification times of the files last loaded
+	modified map[string]time.Time
+	sync.RWMutex
+}
+
+var store = &certStore{}
+
+// loadPeerCert loads our certificate and the cluster CA it must be signed by.
+func loadPeerCert() (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(certFile(), keyFile())
+	if err != nil {
+		return tls.Certificate{}, nil, errors.New("unable to load " + certFile() + "/" + keyFile() + ": " + err.Error())
+	}
+	caCert, err := ioutil.ReadFile(caFile())
+	if err != nil {
+		return tls.Certificate{}, nil, errors.New("unable to load " + caFile() + ": " + err.Error())
+	}
+	pool := x509.NewCertPool()
+	if ok := pool.AppendCertsFromPEM(caCert); !ok {
+		return tls.Certificate{}, nil, errors.New("failed to append ca certs")
+	}
+	return cert, pool, nil
+}
+
+// storeFiles returns the files our certificate store is loaded from.
+func storeFiles() []string {
+	return []string{certFile(), keyFile(), caFile(), CertDir + CRLName}
+}
+
+// modTimes returns the modification time of each of our certificate store files that exists.
+func modTimes() map[string]time.Time {
+	times := map[string]time.Time{}
+	for _, file := range storeFiles() {
+		if info, err := os.Stat(file); err == nil {
+			times[file] = info.ModTime()
+		}
+	}
+	return times
+}
+
+// Changed returns whether the files our certificate store is loaded from have changed since they were last reloaded.
+func Changed() bool {
+	times := modTimes()
+	store.RLock()
+	defer store.RUnlock()
+	if len(times) != len(store.modified) {
+		return true
+	}
+	for file, modified := range times {
+		if !modified.Equal(store.modified[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reload reloads our certificate, the cluster CA and our revocation list.
+// Connections accepted by our server after a reload use them.
+// Note: A failed reload keeps what was loaded before and Changed doesn't report the files again until they change.
+func Reload() error {
+	modified := modTimes()
+	store.Lock()
+	store.modified = modified
+	store.Unlock()
+	cert, pool, err := loadPeerCert()
+	if err != nil {
+		return err
+	}
+	revoked, err := loadRevoked()
+	if err != nil {
+		return err
+	}
+	store.Lock()
+	defer store.Unlock()
+	store.cert = cert
+	store.pool = pool
+	store.revoked = revoked
+	return nil
+}
+
+// IsRevoked returns whether a certificate is in the revocation list our server loaded.
+func IsRevoked(cert *x509.Certificate) bool {
+	store.RLock()
+	defer store.RUnlock()
+	return store.revoked[cert.SerialNumber.Text(16)]
+}
+
+// checkRevoked rejects a connection to or from a member whose certificate has been revoked.
+func checkRevoked(revoked map[string]bool) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) > 0 && revoked[cs.PeerCertificates[0].SerialNumber.Text(16)] {
+			return errors.New("certificate " + cs.PeerCertificates[0].SerialNumber.Text(16) + " has been revoked")
+		}
+		return nil
+	}
+}
+
+// ServerTLSConfig creates the TLS config of our server.
+// Client certificates are verified against ca.crt and our revocation list when given.
+// Note: Connections without a client certificate are only allowed to join as the joining node doesn't have one yet.
+func ServerTLSConfig() (*tls.Config, error) {
+	if err := Reload(); err != nil {
+		return nil, err
+	}
+	return applyPolicy(&tls.Config{
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		// Every connection uses what was last loaded by Reload and our current policy
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			store.RLock()
+			defer store.RUnlock()
+			return applyPolicy(&tls.Config{
+				Certificates:     []tls.Certificate{store.cert},
+				ClientCAs:        store.pool,
+				ClientAuth:       tls.VerifyClientCertIfGiven,
+				VerifyConnection: checkRevoked(store.revoked),
+				// Note: gRPC only sets our protocol on the config it was given
+				NextProtos: []string{"h2"},
+			}), nil
+		},
+	}), nil
+}
+
+// ClientTLSConfig creates the TLS config used to connect to a member.
+// The member's certificate must be signed by ca.crt, issued to the member's hostname and not revoked.
+func ClientTLSConfig(hostname string) (*tls.Config, error) {
+	cert, pool, err := loadPeerCert()
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := loadRevoked()
+	if err != nil {
+		return nil, err
+	}
+	return applyPolicy(&tls.Config{
+		Certificates:     []tls.Certificate{cert},
+		RootCAs:          pool,
+		ServerName:       hostname,
+		VerifyConnection: checkRevoked(revoked),
+	}), nil
+}
+
+// JoinTLSConfig creates the TLS config used to join a cluster before we have the cluster CA.
+// Note: The cluster token authenticates the join instead.
+func JoinTLSConfig() *tls.Config {
+	return applyPolicy(&tls.Config{
+		InsecureSkipVerify: true,
+	})
+}
+
+// CertInfo describes a certificate.
+type CertInfo struct {
+	Subject  string
+	Serial   string
+	NotAfter time.Time
+}
+
+// certInfo describes a certificate.
+func certInfo(cert *x509.Certificate) CertInfo {
+	subject := cert.Subject.CommonName
+	if subject == "" {
+		subject = cert.Subject.String()
+	}
+	return CertInfo{
+		Subject:  subject,
+		Serial:   cert.SerialNumber.Text(16),
+		NotAfter: cert.NotAfter,
+	}
+}
+
+// LocalCertificates describes our certificate and the CA certificates we trust.
+func LocalCertificates() (CertInfo, []CertInfo, error) {
+	cert, _, err := loadPeerCert()
+	if err != nil {
+		return CertInfo{}, nil, err
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return CertInfo{}, nil, err
+	}
+	cas, err := loadCACerts()
+	if err != nil {
+		return CertInfo{}, nil, err
+	}
+	infos := []CertInfo{}
+	for _, ca := range cas {
+		infos = append(infos, certInfo(ca))
+	}
+	return certInfo(leaf), infos, nil
+}
+
+// PeerIdentity returns the hostname a member's certificate was issued to.
+func PeerIdentity(cert *x509.Certificate) (string, error) {
+	if len(cert.DNSNames) == 0 {
+		return "", errors.New("certificate does not contain a hostname")
+	}
+	return cert.DNSNames[0], nil
+}
+
+// CheckPeerCert checks our certificate is signed by ca.crt and identifies us to both our server and client peers.
+// Note: Certificates generated by older versions of PulseHA don't contain our hostname.
+func CheckPeerCert(hostname string) error {
+	cert, pool, err := loadPeerCert()
+	if err != nil {
+		return err
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return err
+	}
+	// Certificates issued by an external CA may be signed by an intermediate CA sent along with them
+	intermediates := x509.NewCertPool()
+	for _, der := range cert.Certificate[1:] {
+		intermediate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return err
+		}
+		intermediates.AddCert(intermediate)
+	}
+	for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth} {
+		if _, err := leaf.Verify(x509.VerifyOptions{
+			DNSName:       hostname,
+			Roots:         pool,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{usage},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
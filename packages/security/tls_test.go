@@ -0,0 +1,217 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+// setupTestCerts generates a cluster CA and a certificate for node1 in a temporary folder.
+func setupTestCerts(t *testing.T) {
+	certDir := CertDir
+	CertDir = t.TempDir() + "/"
+	t.Cleanup(func() {
+		CertDir = certDir
+	})
+	if err := GenerateCACert("127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenTLSKeys("127.0.0.1", "node1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckPeerCert(t *testing.T) {
+	setupTestCerts(t)
+	if err := CheckPeerCert("node1"); err != nil {
+		t.Errorf("expected our certificate to be valid for node1: %s", err)
+	}
+	if err := CheckPeerCert("node2"); err == nil {
+		t.Error("expected our certificate to be invalid for node2")
+	}
+}
+
+// handshake connects to a TLS server and returns the identity of the client certificate the server verified.
+func handshake(t *testing.T, clientConfig *tls.Config) (string, error) {
+	serverConfig, err := ServerTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	identity := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			identity <- ""
+			return
+		}
+		defer conn.Close()
+		tlsConn := conn.(*tls.Conn)
+		if err := tlsConn.Handshake(); err != nil {
+			identity <- ""
+			return
+		}
+		state := tlsConn.ConnectionState()
+		if len(state.VerifiedChains) == 0 {
+			identity <- ""
+			return
+		}
+		hostname, _ := PeerIdentity(state.VerifiedChains[0][0])
+		identity <- hostname
+	}()
+	conn, err := tls.Dial("tcp", l.Addr().String(), clientConfig)
+	if err != nil {
+		return "", err
+	}
+	// Complete the handshake on both ends
+	conn.Write([]byte{0})
+	conn.Close()
+	return <-identity, nil
+}
+
+func TestMutualTLS(t *testing.T) {
+	setupTestCerts(t)
+	config, err := ClientTLSConfig("node1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := handshake(t, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity != "node1" {
+		t.Errorf("expected the server to verify the client as node1, got %q", identity)
+	}
+	// The server must hold a certificate issued to the member we expect
+	config, _ = ClientTLSConfig("node2")
+	if _, err := handshake(t, config); err == nil {
+		t.Error("expected a server certificate issued to another member to be rejected")
+	}
+	// A server certificate signed by another CA is rejected
+	config, _ = ClientTLSConfig("node1")
+	certDir := CertDir
+	CertDir = t.TempDir() + "/"
+	if err := GenerateCACert("127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	otherCA, err := ioutil.ReadFile(CertDir + "ca.crt")
+	CertDir = certDir
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.RootCAs = x509.NewCertPool()
+	config.RootCAs.AppendCertsFromPEM(otherCA)
+	if _, err := handshake(t, config); err == nil {
+		t.Error("expected a certificate signed by another CA to be rejected")
+	}
+}
+
+func TestJoinWithoutClientCert(t *testing.T) {
+	setupTestCerts(t)
+	// Joining nodes connect without a client certificate
+	identity, err := handshake(t, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity != "" {
+		t.Errorf("expected no verified client identity, got %q", identity)
+	}
+}
+
+// serverSerial connects to a TLS server and returns the serial number of the certificate it presented.
+func serverSerial(t *testing.T, serverConfig *tls.Config, clientConfig *tls.Config) string {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.(*tls.Conn).Handshake()
+	}()
+	conn, err := tls.Dial("tcp", l.Addr().String(), clientConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Text(16)
+}
+
+func TestReload(t *testing.T) {
+	setupTestCerts(t)
+	serverConfig, err := ServerTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, _, _ := LocalCertificates()
+	clientConfig, _ := ClientTLSConfig("node1")
+	if serial := serverSerial(t, serverConfig, clientConfig); serial != before.Serial {
+		t.Errorf("expected the server to present %s, got %s", before.Serial, serial)
+	}
+	// Our server keeps its certificate until it is reloaded
+	if err := GenTLSKeys("127.0.0.1", "node1"); err != nil {
+		t.Fatal(err)
+	}
+	after, _, _ := LocalCertificates()
+	clientConfig, _ = ClientTLSConfig("node1")
+	if serial := serverSerial(t, serverConfig, clientConfig); serial != before.Serial {
+		t.Errorf("expected the server to present %s before a reload, got %s", before.Serial, serial)
+	}
+	if err := Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if serial := serverSerial(t, serverConfig, clientConfig); serial != after.Serial {
+		t.Errorf("expected the server to present %s after a reload, got %s", after.Serial, serial)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	setupTestCerts(t)
+	if err := Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if Changed() {
+		t.Error("expected no change after a reload")
+	}
+	// Our certificate is replaced
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(CertDir+CertName+".crt", future, future); err != nil {
+		t.Fatal(err)
+	}
+	if !Changed() {
+		t.Error("expected our certificate to have changed")
+	}
+	if err := Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if Changed() {
+		t.Error("expected no change after a reload")
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -254,3 +255,87 @@ func WriteTextFile(contents string, file string) error {
 	}
 	return nil
 }
+
+// WriteFileAtomic writes a file so it is either completely written or left unchanged.
+// The data is written to a temporary file in the same folder which is synced to disk and renamed over the file.
+func WriteFileAtomic(file string, data []byte, perm os.FileMode) error {
+	return WriteFilesAtomic(AtomicFile{Name: file, Data: data, Perm: perm})
+}
+
+// AtomicFile defines a file written by WriteFilesAtomic.
+type AtomicFile struct {
+	Name string
+	Data []byte
+	Perm os.FileMode
+}
+
+// WriteFilesAtomic writes files that belong together, such as a certificate and its key.
+// Every file is written to a temporary file first and none of them are renamed into place unless all of them were written.
+func WriteFilesAtomic(files ...AtomicFile) error {
+	tmpFiles := make([]string, len(files))
+	// Clean up our temporary files if anything goes wrong
+	defer func() {
+		for _, tmpFile := range tmpFiles {
+			if tmpFile != "" {
+				os.Remove(tmpFile)
+			}
+		}
+	}()
+	for i, file := range files {
+		tmpFile, err := writeTempFile(file)
+		if err != nil {
+			return err
+		}
+		tmpFiles[i] = tmpFile
+	}
+	dirs := map[string]bool{}
+	for i, file := range files {
+		if err := os.Rename(tmpFiles[i], file.Name); err != nil {
+			return err
+		}
+		tmpFiles[i] = ""
+		dirs[filepath.Dir(file.Name)] = true
+	}
+	// Sync the folders so the renames survive a crash
+	for dir := range dirs {
+		d, err := os.Open(dir)
+		if err != nil {
+			return err
+		}
+		err = d.Sync()
+		d.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTempFile writes a file to a temporary file in the same folder and syncs it to disk.
+// Returns the name of the temporary file.
+func writeTempFile(file AtomicFile) (string, error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(file.Name), "."+filepath.Base(file.Name)+".tmp")
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Write(file.Data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Chmod(file.Perm); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
@@ -18,6 +18,9 @@
 package utils
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -120,3 +123,55 @@ func TestHasPort(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.json")
+	if err := WriteFileAtomic(file, []byte("first"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFileAtomic(file, []byte("second"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil || string(b) != "second" {
+		t.Errorf("expected the file to be replaced, got %q %v", b, err)
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected the file permissions to be 0600, got %v", info.Mode().Perm())
+	}
+	// No temporary files are left behind
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("expected a single file, got %d", len(files))
+	}
+}
+
+func TestWriteFilesAtomic(t *testing.T) {
+	dir := t.TempDir()
+	crt, key := filepath.Join(dir, "node.crt"), filepath.Join(dir, "node.key")
+	if err := WriteFilesAtomic(AtomicFile{Name: crt, Data: []byte("crt1"), Perm: 0644}, AtomicFile{Name: key, Data: []byte("key1"), Perm: 0600}); err != nil {
+		t.Fatal(err)
+	}
+	// A file that can't be written leaves every file unchanged
+	err := WriteFilesAtomic(
+		AtomicFile{Name: crt, Data: []byte("crt2"), Perm: 0644},
+		AtomicFile{Name: filepath.Join(dir, "missing", "node.key"), Data: []byte("key2"), Perm: 0600},
+	)
+	if err == nil {
+		t.Fatal("expected an error writing to a folder that doesn't exist")
+	}
+	if b, _ := ioutil.ReadFile(crt); string(b) != "crt1" {
+		t.Errorf("expected the certificate to be left unchanged, got %q", b)
+	}
+	if b, _ := ioutil.ReadFile(key); string(b) != "key1" {
+		t.Errorf("expected the key to be left unchanged, got %q", b)
+	}
+	// No temporary files are left behind
+	if files, _ := ioutil.ReadDir(dir); len(files) != 2 {
+		t.Errorf("expected 2 files, got %d", len(files))
+	}
+}
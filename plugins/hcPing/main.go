@@ -1,6 +1,7 @@
 package main
 
 import (
+	pulseConfig "github.com/syleron/pulseha/packages/config"
 	"github.com/syleron/pulseha/packages/network"
 	"github.com/syleron/pulseha/plugins/hcPing/packages/config"
 	"github.com/syleron/pulseha/src/pulseha"
@@ -32,16 +33,18 @@ func (e PulseHCPing) Weight() int64 {
 	return PluginWeight
 }
 
+// Schema describes our config section so mistakes in it are reported by path.
+// Note: Registered when the plugin is loaded before the config is checked.
+func (e PulseHCPing) Schema() *pulseConfig.Schema {
+	return pulseConfig.SchemaOf(config.Config{})
+}
+
 func (e PulseHCPing) Run(db *pulseha.Database) error {
 	// Set our database variable
 	DB = db
-	
+
 	// Check to see if we have a plugin section
-	conf, err := db.Config.GetPluginConfig(e.Name())
-	
-	
-	// Write default section if one doesn't exist
-	if err != nil {
+	if _, err := db.Config.GetPluginConfig(e.Name()); err != nil {
 		// Define config object
 		c := config.Config{}
 		if err := db.Config.SetPluginConfig(e.Name(), c.GenerateDefaultConfig()); err != nil {
@@ -50,44 +53,39 @@ func (e PulseHCPing) Run(db *pulseha.Database) error {
 		// We had to write a default config so the rest of Run will be skipped
 		return nil
 	}
-	
-	// Type our config section
-	confC := conf.(map[string]interface{})
-	
+
+	// Decode our config section
+	c := config.Config{}
+	if err := db.Config.DecodePluginConfig(e.Name(), &c); err != nil {
+		db.Logging.Error("Invalid " + e.Name() + " plugin config: " + err.Error())
+		return err
+	}
+
 	// Set our custom config options
-	PluginWeight = int64(confC["weight"].(float64))
-	Threshold = int64(confC["threshold"].(float64))
-	FailureCount = int64(confC["failureCount"].(float64))
-	
+	PluginWeight = int64(c.Weight)
+	Threshold = int64(c.Threshold)
+	FailureCount = int64(c.FailureCount)
+
 	return nil
 }
 
 func (e PulseHCPing) Send() error {
 	// Get our config section
-	conf, err := DB.Config.GetPluginConfig(e.Name())
-	
-	// Handle any errors
-	if err != nil {
+	c := config.Config{}
+	if err := DB.Config.DecodePluginConfig(e.Name(), &c); err != nil {
 		return nil
 	}
-	
-	// Type our config section
-	confC := conf.(map[string]interface{})
-	
+
 	// Iterate through our groups
-	for _, group := range confC["groups"].([]interface{}) {
-		g := group.(map[string]interface{})
-		ips := g["ips"].([]interface{})
-		// name := g["name"].(string)
-		
+	for _, group := range c.Groups {
 		// Send our ICMP requests
-		for _, ip := range ips {
-			if err := network.ICMPv4(ip.(string)); err != nil {
+		for _, ip := range group.Ips {
+			if err := network.ICMPv4(ip); err != nil {
 				return err
 			}
-		} 
+		}
 	}
-	
+
 	return nil
 }
 
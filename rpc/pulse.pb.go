@@ -89,19 +89,20 @@ func (x LogsRequest_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogsRequest_Level.Descriptor instead.
 func (LogsRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{18, 0}
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{35, 0}
 }
 
 type MemberStatus_Status int32
 
 const (
 	MemberStatus_ACTIVE       MemberStatus_Status = 0 // We are active and responsible for live services
-	MemberStatus_LEAVING      MemberStatus_Status = 1 // ??
+	MemberStatus_LEAVING      MemberStatus_Status = 1 // Node is in the process of leaving the cluster
 	MemberStatus_PASSIVE      MemberStatus_Status = 2 // okay and waiting to become active
 	MemberStatus_UNAVAILABLE  MemberStatus_Status = 3 // dead
 	MemberStatus_SUSPICIOUS   MemberStatus_Status = 4 // potentially dead but given the benefit of the doubt.
 	MemberStatus_MONITORING   MemberStatus_Status = 5 // Node is permanently passive and monitoring
 	MemberStatus_UNCONFIGURED MemberStatus_Status = 6 // Node is currently in an un-configured state
+	MemberStatus_MAINTENANCE  MemberStatus_Status = 7 // Node is drained for maintenance and excluded from election
 )
 
 // Enum value maps for MemberStatus_Status.
@@ -114,6 +115,7 @@ var (
 		4: "SUSPICIOUS",
 		5: "MONITORING",
 		6: "UNCONFIGURED",
+		7: "MAINTENANCE",
 	}
 	MemberStatus_Status_value = map[string]int32{
 		"ACTIVE":       0,
@@ -123,6 +125,7 @@ var (
 		"SUSPICIOUS":   4,
 		"MONITORING":   5,
 		"UNCONFIGURED": 6,
+		"MAINTENANCE":  7,
 	}
 )
 
@@ -150,7 +153,7 @@ func (x MemberStatus_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MemberStatus_Status.Descriptor instead.
 func (MemberStatus_Status) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{25, 0}
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{42, 0}
 }
 
 type HealthCheckRequest struct {
@@ -162,6 +165,14 @@ type HealthCheckRequest struct {
 	Memberlist []*MemberlistMember `protobuf:"bytes,1,rep,name=memberlist,proto3" json:"memberlist,omitempty"`
 	// When the node went active
 	ActiveTime string `protobuf:"bytes,2,opt,name=ActiveTime,proto3" json:"ActiveTime,omitempty"`
+	// The election term of the sending active node
+	Term uint64 `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	// The hostname of the sending active node
+	Hostname string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The config version of the sending active node
+	ConfigVersion *ConfigVersion `protobuf:"bytes,5,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	// The config hash of the sending active node
+	ConfigHash string `protobuf:"bytes,6,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"`
 }
 
 func (x *HealthCheckRequest) Reset() {
@@ -210,12 +221,48 @@ func (x *HealthCheckRequest) GetActiveTime() string {
 	return ""
 }
 
+func (x *HealthCheckRequest) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *HealthCheckRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HealthCheckRequest) GetConfigVersion() *ConfigVersion {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return nil
+}
+
+func (x *HealthCheckRequest) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
 type HealthCheckResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Score int32 `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	// The election term known to the receiving node
+	Term uint64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	// The hostname of the receiving node
+	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The config version of the receiving node
+	ConfigVersion *ConfigVersion `protobuf:"bytes,4,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	// The config hash of the receiving node
+	ConfigHash string `protobuf:"bytes,5,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"`
 }
 
 func (x *HealthCheckResponse) Reset() {
@@ -257,6 +304,148 @@ func (x *HealthCheckResponse) GetScore() int32 {
 	return 0
 }
 
+func (x *HealthCheckResponse) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetConfigVersion() *ConfigVersion {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return nil
+}
+
+func (x *HealthCheckResponse) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hostname of the sending member
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The members the sending member can reach
+	Reachable []string `protobuf:"bytes,2,rep,name=reachable,proto3" json:"reachable,omitempty"`
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HeartbeatRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetReachable() []string {
+	if x != nil {
+		return x.Reachable
+	}
+	return nil
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hostname of the receiving member
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The members the receiving member can reach
+	Reachable []string `protobuf:"bytes,2,rep,name=reachable,proto3" json:"reachable,omitempty"`
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HeartbeatResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HeartbeatResponse) GetReachable() []string {
+	if x != nil {
+		return x.Reachable
+	}
+	return nil
+}
+
 type JoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -273,12 +462,14 @@ type JoinRequest struct {
 	CaCrt      string `protobuf:"bytes,9,opt,name=ca_crt,json=caCrt,proto3" json:"ca_crt,omitempty"`
 	CaKey      string `protobuf:"bytes,10,opt,name=ca_key,json=caKey,proto3" json:"ca_key,omitempty"`
 	Uid        string `protobuf:"bytes,12,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The certificate signing request of the joining node
+	Csr []byte `protobuf:"bytes,13,opt,name=csr,proto3" json:"csr,omitempty"`
 }
 
 func (x *JoinRequest) Reset() {
 	*x = JoinRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[2]
+		mi := &file_rpc_pulse_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +482,7 @@ func (x *JoinRequest) String() string {
 func (*JoinRequest) ProtoMessage() {}
 
 func (x *JoinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[2]
+	mi := &file_rpc_pulse_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +495,7 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
 func (*JoinRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{2}
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *JoinRequest) GetMessage() string {
@@ -384,6 +575,13 @@ func (x *JoinRequest) GetUid() string {
 	return ""
 }
 
+func (x *JoinRequest) GetCsr() []byte {
+	if x != nil {
+		return x.Csr
+	}
+	return nil
+}
+
 type JoinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -393,14 +591,17 @@ type JoinResponse struct {
 	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Config    []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 	CaCrt     string `protobuf:"bytes,4,opt,name=ca_crt,json=caCrt,proto3" json:"ca_crt,omitempty"`
-	CaKey     string `protobuf:"bytes,5,opt,name=ca_key,json=caKey,proto3" json:"ca_key,omitempty"`
 	ErrorCode int32  `protobuf:"varint,6,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// The certificate signed for the joining node
+	Crt string `protobuf:"bytes,7,opt,name=crt,proto3" json:"crt,omitempty"`
+	// The certificate revocation list of the cluster
+	Crl string `protobuf:"bytes,8,opt,name=crl,proto3" json:"crl,omitempty"`
 }
 
 func (x *JoinResponse) Reset() {
 	*x = JoinResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[3]
+		mi := &file_rpc_pulse_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +614,7 @@ func (x *JoinResponse) String() string {
 func (*JoinResponse) ProtoMessage() {}
 
 func (x *JoinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[3]
+	mi := &file_rpc_pulse_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +627,7 @@ func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
 func (*JoinResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{3}
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *JoinResponse) GetSuccess() bool {
@@ -457,47 +658,59 @@ func (x *JoinResponse) GetCaCrt() string {
 	return ""
 }
 
-func (x *JoinResponse) GetCaKey() string {
+func (x *JoinResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *JoinResponse) GetCrt() string {
 	if x != nil {
-		return x.CaKey
+		return x.Crt
 	}
 	return ""
 }
 
-func (x *JoinResponse) GetErrorCode() int32 {
+func (x *JoinResponse) GetCrl() string {
 	if x != nil {
-		return x.ErrorCode
+		return x.Crl
 	}
-	return 0
+	return ""
 }
 
-type ConfigSyncRequest struct {
+type SignCertificateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Config     []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Replicated bool   `protobuf:"varint,3,opt,name=replicated,proto3" json:"replicated,omitempty"`
+	// The hostname the certificate is issued to
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The bind IP of the member
+	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	// The certificate signing request of the member
+	Csr []byte `protobuf:"bytes,3,opt,name=csr,proto3" json:"csr,omitempty"`
+	// The hash of the cluster token a new member joined with
+	TokenHash string `protobuf:"bytes,4,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
 }
 
-func (x *ConfigSyncRequest) Reset() {
-	*x = ConfigSyncRequest{}
+func (x *SignCertificateRequest) Reset() {
+	*x = SignCertificateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[4]
+		mi := &file_rpc_pulse_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigSyncRequest) String() string {
+func (x *SignCertificateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigSyncRequest) ProtoMessage() {}
+func (*SignCertificateRequest) ProtoMessage() {}
 
-func (x *ConfigSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[4]
+func (x *SignCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,59 +721,67 @@ func (x *ConfigSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigSyncRequest.ProtoReflect.Descriptor instead.
-func (*ConfigSyncRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SignCertificateRequest.ProtoReflect.Descriptor instead.
+func (*SignCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ConfigSyncRequest) GetMessage() string {
+func (x *SignCertificateRequest) GetHostname() string {
 	if x != nil {
-		return x.Message
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *ConfigSyncRequest) GetConfig() []byte {
+func (x *SignCertificateRequest) GetIp() string {
 	if x != nil {
-		return x.Config
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SignCertificateRequest) GetCsr() []byte {
+	if x != nil {
+		return x.Csr
 	}
 	return nil
 }
 
-func (x *ConfigSyncRequest) GetReplicated() bool {
+func (x *SignCertificateRequest) GetTokenHash() string {
 	if x != nil {
-		return x.Replicated
+		return x.TokenHash
 	}
-	return false
+	return ""
 }
 
-type ConfigSyncResponse struct {
+type SignCertificateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The signed certificate
+	Crt string `protobuf:"bytes,3,opt,name=crt,proto3" json:"crt,omitempty"`
 }
 
-func (x *ConfigSyncResponse) Reset() {
-	*x = ConfigSyncResponse{}
+func (x *SignCertificateResponse) Reset() {
+	*x = SignCertificateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[5]
+		mi := &file_rpc_pulse_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigSyncResponse) String() string {
+func (x *SignCertificateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigSyncResponse) ProtoMessage() {}
+func (*SignCertificateResponse) ProtoMessage() {}
 
-func (x *ConfigSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[5]
+func (x *SignCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,59 +792,62 @@ func (x *ConfigSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigSyncResponse.ProtoReflect.Descriptor instead.
-func (*ConfigSyncResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SignCertificateResponse.ProtoReflect.Descriptor instead.
+func (*SignCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ConfigSyncResponse) GetSuccess() bool {
+func (x *SignCertificateResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ConfigSyncResponse) GetMessage() string {
+func (x *SignCertificateResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ConfigSyncResponse) GetErrorCode() int32 {
+func (x *SignCertificateResponse) GetCrt() string {
 	if x != nil {
-		return x.ErrorCode
+		return x.Crt
 	}
-	return 0
+	return ""
 }
 
-type LeaveRequest struct {
+type CertificateInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Replicated bool   `protobuf:"varint,2,opt,name=replicated,proto3" json:"replicated,omitempty"`
-	Hostname   string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The hostname or CA name the certificate is issued to
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The serial number in hex
+	Serial string `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	// When the certificate expires in RFC3339
+	NotAfter string `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
 }
 
-func (x *LeaveRequest) Reset() {
-	*x = LeaveRequest{}
+func (x *CertificateInfo) Reset() {
+	*x = CertificateInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[6]
+		mi := &file_rpc_pulse_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaveRequest) String() string {
+func (x *CertificateInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRequest) ProtoMessage() {}
+func (*CertificateInfo) ProtoMessage() {}
 
-func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[6]
+func (x *CertificateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,59 +858,55 @@ func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
-func (*LeaveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CertificateInfo.ProtoReflect.Descriptor instead.
+func (*CertificateInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LeaveRequest) GetMessage() string {
+func (x *CertificateInfo) GetSubject() string {
 	if x != nil {
-		return x.Message
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *LeaveRequest) GetReplicated() bool {
+func (x *CertificateInfo) GetSerial() string {
 	if x != nil {
-		return x.Replicated
+		return x.Serial
 	}
-	return false
+	return ""
 }
 
-func (x *LeaveRequest) GetHostname() string {
+func (x *CertificateInfo) GetNotAfter() string {
 	if x != nil {
-		return x.Hostname
+		return x.NotAfter
 	}
 	return ""
 }
 
-type LeaveResponse struct {
+type CertificateStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *LeaveResponse) Reset() {
-	*x = LeaveResponse{}
+func (x *CertificateStatusRequest) Reset() {
+	*x = CertificateStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[7]
+		mi := &file_rpc_pulse_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaveResponse) String() string {
+func (x *CertificateStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveResponse) ProtoMessage() {}
+func (*CertificateStatusRequest) ProtoMessage() {}
 
-func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[7]
+func (x *CertificateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,60 +917,136 @@ func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
-func (*LeaveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CertificateStatusRequest.ProtoReflect.Descriptor instead.
+func (*CertificateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LeaveResponse) GetSuccess() bool {
+type CertificateStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The certificate of the member
+	Cert *CertificateInfo `protobuf:"bytes,3,opt,name=cert,proto3" json:"cert,omitempty"`
+	// The CA certificates the member trusts
+	Cas []*CertificateInfo `protobuf:"bytes,4,rep,name=cas,proto3" json:"cas,omitempty"`
+	// Whether the member holds the CA key
+	CaKey bool `protobuf:"varint,5,opt,name=ca_key,json=caKey,proto3" json:"ca_key,omitempty"`
+	// The number of the member's revocation list
+	CrlNumber uint64 `protobuf:"varint,6,opt,name=crl_number,json=crlNumber,proto3" json:"crl_number,omitempty"`
+}
+
+func (x *CertificateStatusResponse) Reset() {
+	*x = CertificateStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateStatusResponse) ProtoMessage() {}
+
+func (x *CertificateStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateStatusResponse.ProtoReflect.Descriptor instead.
+func (*CertificateStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CertificateStatusResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *LeaveResponse) GetMessage() string {
+func (x *CertificateStatusResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *LeaveResponse) GetErrorCode() int32 {
+func (x *CertificateStatusResponse) GetCert() *CertificateInfo {
 	if x != nil {
-		return x.ErrorCode
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *CertificateStatusResponse) GetCas() []*CertificateInfo {
+	if x != nil {
+		return x.Cas
+	}
+	return nil
+}
+
+func (x *CertificateStatusResponse) GetCaKey() bool {
+	if x != nil {
+		return x.CaKey
+	}
+	return false
+}
+
+func (x *CertificateStatusResponse) GetCrlNumber() uint64 {
+	if x != nil {
+		return x.CrlNumber
 	}
 	return 0
 }
 
-type RemoveRequest struct {
+type CertUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Hostname   string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Replicated bool   `protobuf:"varint,3,opt,name=replicated,proto3" json:"replicated,omitempty"`
-	ErrorCode  int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// The CA certificates to trust
+	CaCrt string `protobuf:"bytes,1,opt,name=ca_crt,json=caCrt,proto3" json:"ca_crt,omitempty"`
+	// The signature of ca_crt by a CA the member already trusts
+	CaSignature []byte `protobuf:"bytes,2,opt,name=ca_signature,json=caSignature,proto3" json:"ca_signature,omitempty"`
+	// The CA key, only sent to members that already hold one
+	CaKey string `protobuf:"bytes,3,opt,name=ca_key,json=caKey,proto3" json:"ca_key,omitempty"`
+	// The certificate revocation list
+	Crl string `protobuf:"bytes,4,opt,name=crl,proto3" json:"crl,omitempty"`
+	// Whether the member should renew its certificate
+	Renew bool `protobuf:"varint,5,opt,name=renew,proto3" json:"renew,omitempty"`
 }
 
-func (x *RemoveRequest) Reset() {
-	*x = RemoveRequest{}
+func (x *CertUpdateRequest) Reset() {
+	*x = CertUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[8]
+		mi := &file_rpc_pulse_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveRequest) String() string {
+func (x *CertUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRequest) ProtoMessage() {}
+func (*CertUpdateRequest) ProtoMessage() {}
 
-func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[8]
+func (x *CertUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,67 +1057,72 @@ func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CertUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CertUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RemoveRequest) GetMessage() string {
+func (x *CertUpdateRequest) GetCaCrt() string {
 	if x != nil {
-		return x.Message
+		return x.CaCrt
 	}
 	return ""
 }
 
-func (x *RemoveRequest) GetHostname() string {
+func (x *CertUpdateRequest) GetCaSignature() []byte {
 	if x != nil {
-		return x.Hostname
+		return x.CaSignature
+	}
+	return nil
+}
+
+func (x *CertUpdateRequest) GetCaKey() string {
+	if x != nil {
+		return x.CaKey
 	}
 	return ""
 }
 
-func (x *RemoveRequest) GetReplicated() bool {
+func (x *CertUpdateRequest) GetCrl() string {
 	if x != nil {
-		return x.Replicated
+		return x.Crl
 	}
-	return false
+	return ""
 }
 
-func (x *RemoveRequest) GetErrorCode() int32 {
+func (x *CertUpdateRequest) GetRenew() bool {
 	if x != nil {
-		return x.ErrorCode
+		return x.Renew
 	}
-	return 0
+	return false
 }
 
-type RemoveResponse struct {
+type CertUpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Hostname  string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	ErrorCode int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RemoveResponse) Reset() {
-	*x = RemoveResponse{}
+func (x *CertUpdateResponse) Reset() {
+	*x = CertUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[9]
+		mi := &file_rpc_pulse_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveResponse) String() string {
+func (x *CertUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveResponse) ProtoMessage() {}
+func (*CertUpdateResponse) ProtoMessage() {}
 
-func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[9]
+func (x *CertUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,66 +1133,52 @@ func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
-func (*RemoveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CertUpdateResponse.ProtoReflect.Descriptor instead.
+func (*CertUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RemoveResponse) GetSuccess() bool {
+func (x *CertUpdateResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RemoveResponse) GetMessage() string {
+func (x *CertUpdateResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *RemoveResponse) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *RemoveResponse) GetErrorCode() int32 {
-	if x != nil {
-		return x.ErrorCode
-	}
-	return 0
-}
-
-type PromoteRequest struct {
+type ConfigSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message   string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Config     []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Replicated bool   `protobuf:"varint,3,opt,name=replicated,proto3" json:"replicated,omitempty"`
 }
 
-func (x *PromoteRequest) Reset() {
-	*x = PromoteRequest{}
+func (x *ConfigSyncRequest) Reset() {
+	*x = ConfigSyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[10]
+		mi := &file_rpc_pulse_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PromoteRequest) String() string {
+func (x *ConfigSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoteRequest) ProtoMessage() {}
+func (*ConfigSyncRequest) ProtoMessage() {}
 
-func (x *PromoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[10]
+func (x *ConfigSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,33 +1189,33 @@ func (x *PromoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoteRequest.ProtoReflect.Descriptor instead.
-func (*PromoteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ConfigSyncRequest.ProtoReflect.Descriptor instead.
+func (*ConfigSyncRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PromoteRequest) GetMessage() string {
+func (x *ConfigSyncRequest) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *PromoteRequest) GetMember() string {
+func (x *ConfigSyncRequest) GetConfig() []byte {
 	if x != nil {
-		return x.Member
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-func (x *PromoteRequest) GetErrorCode() int32 {
+func (x *ConfigSyncRequest) GetReplicated() bool {
 	if x != nil {
-		return x.ErrorCode
+		return x.Replicated
 	}
-	return 0
+	return false
 }
 
-type PromoteResponse struct {
+type ConfigSyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -936,25 +1223,27 @@ type PromoteResponse struct {
 	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// The config version of the receiving node
+	Version *ConfigVersion `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *PromoteResponse) Reset() {
-	*x = PromoteResponse{}
+func (x *ConfigSyncResponse) Reset() {
+	*x = ConfigSyncResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[11]
+		mi := &file_rpc_pulse_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PromoteResponse) String() string {
+func (x *ConfigSyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoteResponse) ProtoMessage() {}
+func (*ConfigSyncResponse) ProtoMessage() {}
 
-func (x *PromoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[11]
+func (x *ConfigSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,59 +1254,66 @@ func (x *PromoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoteResponse.ProtoReflect.Descriptor instead.
-func (*PromoteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ConfigSyncResponse.ProtoReflect.Descriptor instead.
+func (*ConfigSyncResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PromoteResponse) GetSuccess() bool {
+func (x *ConfigSyncResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *PromoteResponse) GetMessage() string {
+func (x *ConfigSyncResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *PromoteResponse) GetErrorCode() int32 {
+func (x *ConfigSyncResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type MakePassiveRequest struct {
+func (x *ConfigSyncResponse) GetVersion() *ConfigVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type ConfigVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message   string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// The hostname of the node that made the change
+	Origin string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
-func (x *MakePassiveRequest) Reset() {
-	*x = MakePassiveRequest{}
+func (x *ConfigVersion) Reset() {
+	*x = ConfigVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[12]
+		mi := &file_rpc_pulse_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakePassiveRequest) String() string {
+func (x *ConfigVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakePassiveRequest) ProtoMessage() {}
+func (*ConfigVersion) ProtoMessage() {}
 
-func (x *MakePassiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[12]
+func (x *ConfigVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,59 +1324,51 @@ func (x *MakePassiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakePassiveRequest.ProtoReflect.Descriptor instead.
-func (*MakePassiveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ConfigVersion.ProtoReflect.Descriptor instead.
+func (*ConfigVersion) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MakePassiveRequest) GetMessage() string {
+func (x *ConfigVersion) GetNumber() uint64 {
 	if x != nil {
-		return x.Message
+		return x.Number
 	}
-	return ""
+	return 0
 }
 
-func (x *MakePassiveRequest) GetMember() string {
+func (x *ConfigVersion) GetOrigin() string {
 	if x != nil {
-		return x.Member
+		return x.Origin
 	}
 	return ""
 }
 
-func (x *MakePassiveRequest) GetErrorCode() int32 {
-	if x != nil {
-		return x.ErrorCode
-	}
-	return 0
-}
-
-type MakePassiveResponse struct {
+type ConfigVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// Whether to include the config
+	Config bool `protobuf:"varint,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *MakePassiveResponse) Reset() {
-	*x = MakePassiveResponse{}
+func (x *ConfigVersionRequest) Reset() {
+	*x = ConfigVersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[13]
+		mi := &file_rpc_pulse_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakePassiveResponse) String() string {
+func (x *ConfigVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakePassiveResponse) ProtoMessage() {}
+func (*ConfigVersionRequest) ProtoMessage() {}
 
-func (x *MakePassiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[13]
+func (x *ConfigVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,59 +1379,47 @@ func (x *MakePassiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakePassiveResponse.ProtoReflect.Descriptor instead.
-func (*MakePassiveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ConfigVersionRequest.ProtoReflect.Descriptor instead.
+func (*ConfigVersionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MakePassiveResponse) GetSuccess() bool {
+func (x *ConfigVersionRequest) GetConfig() bool {
 	if x != nil {
-		return x.Success
+		return x.Config
 	}
 	return false
 }
 
-func (x *MakePassiveResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *MakePassiveResponse) GetErrorCode() int32 {
-	if x != nil {
-		return x.ErrorCode
-	}
-	return 0
-}
-
-type UpIpRequest struct {
+type ConfigVersionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Iface   string   `protobuf:"bytes,2,opt,name=iface,proto3" json:"iface,omitempty"`
-	Ips     []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+	Success  bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hostname string         `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Version  *ConfigVersion `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Config   []byte         `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *UpIpRequest) Reset() {
-	*x = UpIpRequest{}
+func (x *ConfigVersionResponse) Reset() {
+	*x = ConfigVersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[14]
+		mi := &file_rpc_pulse_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpIpRequest) String() string {
+func (x *ConfigVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpIpRequest) ProtoMessage() {}
+func (*ConfigVersionResponse) ProtoMessage() {}
 
-func (x *UpIpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[14]
+func (x *ConfigVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,59 +1430,73 @@ func (x *UpIpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpIpRequest.ProtoReflect.Descriptor instead.
-func (*UpIpRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ConfigVersionResponse.ProtoReflect.Descriptor instead.
+func (*ConfigVersionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpIpRequest) GetMessage() string {
+func (x *ConfigVersionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigVersionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UpIpRequest) GetIface() string {
+func (x *ConfigVersionResponse) GetHostname() string {
 	if x != nil {
-		return x.Iface
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *UpIpRequest) GetIps() []string {
+func (x *ConfigVersionResponse) GetVersion() *ConfigVersion {
 	if x != nil {
-		return x.Ips
+		return x.Version
 	}
 	return nil
 }
 
-type UpIpResponse struct {
+func (x *ConfigVersionResponse) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type LeaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Replicated bool   `protobuf:"varint,2,opt,name=replicated,proto3" json:"replicated,omitempty"`
+	Hostname   string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
 }
 
-func (x *UpIpResponse) Reset() {
-	*x = UpIpResponse{}
+func (x *LeaveRequest) Reset() {
+	*x = LeaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[15]
+		mi := &file_rpc_pulse_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpIpResponse) String() string {
+func (x *LeaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpIpResponse) ProtoMessage() {}
+func (*LeaveRequest) ProtoMessage() {}
 
-func (x *UpIpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[15]
+func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,59 +1507,59 @@ func (x *UpIpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpIpResponse.ProtoReflect.Descriptor instead.
-func (*UpIpResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpIpResponse) GetSuccess() bool {
+func (x *LeaveRequest) GetMessage() string {
 	if x != nil {
-		return x.Success
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *UpIpResponse) GetMessage() string {
+func (x *LeaveRequest) GetReplicated() bool {
 	if x != nil {
-		return x.Message
+		return x.Replicated
 	}
-	return ""
+	return false
 }
 
-func (x *UpIpResponse) GetErrorCode() int32 {
+func (x *LeaveRequest) GetHostname() string {
 	if x != nil {
-		return x.ErrorCode
+		return x.Hostname
 	}
-	return 0
+	return ""
 }
 
-type DownIpRequest struct {
+type LeaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Iface   string   `protobuf:"bytes,2,opt,name=iface,proto3" json:"iface,omitempty"`
-	Ips     []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *DownIpRequest) Reset() {
-	*x = DownIpRequest{}
+func (x *LeaveResponse) Reset() {
+	*x = LeaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[16]
+		mi := &file_rpc_pulse_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownIpRequest) String() string {
+func (x *LeaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownIpRequest) ProtoMessage() {}
+func (*LeaveResponse) ProtoMessage() {}
 
-func (x *DownIpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[16]
+func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,59 +1570,60 @@ func (x *DownIpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownIpRequest.ProtoReflect.Descriptor instead.
-func (*DownIpRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
+func (*LeaveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DownIpRequest) GetMessage() string {
+func (x *LeaveResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Message
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *DownIpRequest) GetIface() string {
+func (x *LeaveResponse) GetMessage() string {
 	if x != nil {
-		return x.Iface
+		return x.Message
 	}
 	return ""
 }
 
-func (x *DownIpRequest) GetIps() []string {
+func (x *LeaveResponse) GetErrorCode() int32 {
 	if x != nil {
-		return x.Ips
+		return x.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-type DownIpResponse struct {
+type RemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Hostname   string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Replicated bool   `protobuf:"varint,3,opt,name=replicated,proto3" json:"replicated,omitempty"`
+	ErrorCode  int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *DownIpResponse) Reset() {
-	*x = DownIpResponse{}
+func (x *RemoveRequest) Reset() {
+	*x = RemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[17]
+		mi := &file_rpc_pulse_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownIpResponse) String() string {
+func (x *RemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownIpResponse) ProtoMessage() {}
+func (*RemoveRequest) ProtoMessage() {}
 
-func (x *DownIpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[17]
+func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,59 +1634,67 @@ func (x *DownIpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownIpResponse.ProtoReflect.Descriptor instead.
-func (*DownIpResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DownIpResponse) GetSuccess() bool {
+func (x *RemoveRequest) GetMessage() string {
 	if x != nil {
-		return x.Success
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *DownIpResponse) GetMessage() string {
+func (x *RemoveRequest) GetHostname() string {
 	if x != nil {
-		return x.Message
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *DownIpResponse) GetErrorCode() int32 {
+func (x *RemoveRequest) GetReplicated() bool {
+	if x != nil {
+		return x.Replicated
+	}
+	return false
+}
+
+func (x *RemoveRequest) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type LogsRequest struct {
+type RemoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Node    string            `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	Level   LogsRequest_Level `protobuf:"varint,3,opt,name=level,proto3,enum=proto.LogsRequest_Level" json:"level,omitempty"`
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hostname  string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	ErrorCode int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *LogsRequest) Reset() {
-	*x = LogsRequest{}
+func (x *RemoveResponse) Reset() {
+	*x = RemoveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[18]
+		mi := &file_rpc_pulse_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogsRequest) String() string {
+func (x *RemoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsRequest) ProtoMessage() {}
+func (*RemoveResponse) ProtoMessage() {}
 
-func (x *LogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[18]
+func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,59 +1705,70 @@ func (x *LogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsRequest.ProtoReflect.Descriptor instead.
-func (*LogsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *LogsRequest) GetMessage() string {
+func (x *RemoveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *LogsRequest) GetNode() string {
+func (x *RemoveResponse) GetHostname() string {
 	if x != nil {
-		return x.Node
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *LogsRequest) GetLevel() LogsRequest_Level {
+func (x *RemoveResponse) GetErrorCode() int32 {
 	if x != nil {
-		return x.Level
+		return x.ErrorCode
 	}
-	return LogsRequest_INFO
+	return 0
 }
 
-type LogsResponse struct {
+type PromoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Message   string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// Skip the health score comparison with the current active
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// Ping the addresses of the member's floating IP groups once it is active
+	Ping bool `protobuf:"varint,5,opt,name=ping,proto3" json:"ping,omitempty"`
 }
 
-func (x *LogsResponse) Reset() {
-	*x = LogsResponse{}
+func (x *PromoteRequest) Reset() {
+	*x = PromoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[19]
+		mi := &file_rpc_pulse_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogsResponse) String() string {
+func (x *PromoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsResponse) ProtoMessage() {}
+func (*PromoteRequest) ProtoMessage() {}
 
-func (x *LogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[19]
+func (x *PromoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1469,67 +1779,75 @@ func (x *LogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsResponse.ProtoReflect.Descriptor instead.
-func (*LogsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PromoteRequest.ProtoReflect.Descriptor instead.
+func (*PromoteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *LogsResponse) GetSuccess() bool {
+func (x *PromoteRequest) GetMessage() string {
 	if x != nil {
-		return x.Success
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *LogsResponse) GetMessage() string {
+func (x *PromoteRequest) GetMember() string {
 	if x != nil {
-		return x.Message
+		return x.Member
 	}
 	return ""
 }
 
-func (x *LogsResponse) GetErrorCode() int32 {
+func (x *PromoteRequest) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type DescribeRequest struct {
+func (x *PromoteRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *PromoteRequest) GetPing() bool {
+	if x != nil {
+		return x.Ping
+	}
+	return false
+}
+
+type PromoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message      string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	CpuUsage     int32  `protobuf:"varint,2,opt,name=cpuUsage,proto3" json:"cpuUsage,omitempty"`
-	MemUsage     int32  `protobuf:"varint,3,opt,name=memUsage,proto3" json:"memUsage,omitempty"`
-	Uid          string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
-	DiskUsage    int32  `protobuf:"varint,5,opt,name=diskUsage,proto3" json:"diskUsage,omitempty"`
-	Uptime       string `protobuf:"bytes,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	Status       string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	Latency      string `protobuf:"bytes,8,opt,name=latency,proto3" json:"latency,omitempty"`
-	Hostname     string `protobuf:"bytes,9,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	BindAddress  string `protobuf:"bytes,10,opt,name=bindAddress,proto3" json:"bindAddress,omitempty"`
-	LastReceived string `protobuf:"bytes,11,opt,name=lastReceived,proto3" json:"lastReceived,omitempty"`
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// The steps taken during the switchover
+	Steps []*SwitchoverStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
 }
 
-func (x *DescribeRequest) Reset() {
-	*x = DescribeRequest{}
+func (x *PromoteResponse) Reset() {
+	*x = PromoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[20]
+		mi := &file_rpc_pulse_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DescribeRequest) String() string {
+func (x *PromoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeRequest) ProtoMessage() {}
+func (*PromoteResponse) ProtoMessage() {}
 
-func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[20]
+func (x *PromoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,115 +1858,177 @@ func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeRequest.ProtoReflect.Descriptor instead.
-func (*DescribeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PromoteResponse.ProtoReflect.Descriptor instead.
+func (*PromoteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DescribeRequest) GetMessage() string {
+func (x *PromoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PromoteResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *DescribeRequest) GetCpuUsage() int32 {
+func (x *PromoteResponse) GetErrorCode() int32 {
 	if x != nil {
-		return x.CpuUsage
+		return x.ErrorCode
 	}
 	return 0
 }
 
-func (x *DescribeRequest) GetMemUsage() int32 {
+func (x *PromoteResponse) GetSteps() []*SwitchoverStep {
 	if x != nil {
-		return x.MemUsage
+		return x.Steps
 	}
-	return 0
+	return nil
 }
 
-func (x *DescribeRequest) GetUid() string {
-	if x != nil {
-		return x.Uid
+type SwitchoverStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SwitchoverStep) Reset() {
+	*x = SwitchoverStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *DescribeRequest) GetDiskUsage() int32 {
-	if x != nil {
-		return x.DiskUsage
+func (x *SwitchoverStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchoverStep) ProtoMessage() {}
+
+func (x *SwitchoverStep) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DescribeRequest) GetUptime() string {
+// Deprecated: Use SwitchoverStep.ProtoReflect.Descriptor instead.
+func (*SwitchoverStep) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SwitchoverStep) GetName() string {
 	if x != nil {
-		return x.Uptime
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DescribeRequest) GetStatus() string {
+func (x *SwitchoverStep) GetSuccess() bool {
 	if x != nil {
-		return x.Status
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *DescribeRequest) GetLatency() string {
+func (x *SwitchoverStep) GetMessage() string {
 	if x != nil {
-		return x.Latency
+		return x.Message
 	}
 	return ""
 }
 
-func (x *DescribeRequest) GetHostname() string {
-	if x != nil {
-		return x.Hostname
+type PreflightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *PreflightRequest) Reset() {
+	*x = PreflightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *DescribeRequest) GetBindAddress() string {
-	if x != nil {
-		return x.BindAddress
+func (x *PreflightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreflightRequest) ProtoMessage() {}
+
+func (x *PreflightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DescribeRequest) GetLastReceived() string {
+// Deprecated: Use PreflightRequest.ProtoReflect.Descriptor instead.
+func (*PreflightRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PreflightRequest) GetMember() string {
 	if x != nil {
-		return x.LastReceived
+		return x.Member
 	}
 	return ""
 }
 
-type DescribeResponse struct {
+type PreflightResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The health check score of the member
+	Score int32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *DescribeResponse) Reset() {
-	*x = DescribeResponse{}
+func (x *PreflightResponse) Reset() {
+	*x = PreflightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[21]
+		mi := &file_rpc_pulse_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DescribeResponse) String() string {
+func (x *PreflightResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeResponse) ProtoMessage() {}
+func (*PreflightResponse) ProtoMessage() {}
 
-func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[21]
+func (x *PreflightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,58 +2039,58 @@ func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeResponse.ProtoReflect.Descriptor instead.
-func (*DescribeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PreflightResponse.ProtoReflect.Descriptor instead.
+func (*PreflightResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DescribeResponse) GetSuccess() bool {
+func (x *PreflightResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DescribeResponse) GetMessage() string {
+func (x *PreflightResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *DescribeResponse) GetErrorCode() int32 {
+func (x *PreflightResponse) GetScore() int32 {
 	if x != nil {
-		return x.ErrorCode
+		return x.Score
 	}
 	return 0
 }
 
-type VoteRequest struct {
+type GroupStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Announce the addresses of the groups held using gratuitous ARP
+	Announce bool `protobuf:"varint,1,opt,name=announce,proto3" json:"announce,omitempty"`
 }
 
-func (x *VoteRequest) Reset() {
-	*x = VoteRequest{}
+func (x *GroupStatusRequest) Reset() {
+	*x = GroupStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[22]
+		mi := &file_rpc_pulse_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VoteRequest) String() string {
+func (x *GroupStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteRequest) ProtoMessage() {}
+func (*GroupStatusRequest) ProtoMessage() {}
 
-func (x *VoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[22]
+func (x *GroupStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,52 +2101,46 @@ func (x *VoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
-func (*VoteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GroupStatusRequest.ProtoReflect.Descriptor instead.
+func (*GroupStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *VoteRequest) GetSuccess() bool {
+func (x *GroupStatusRequest) GetAnnounce() bool {
 	if x != nil {
-		return x.Success
+		return x.Announce
 	}
 	return false
 }
 
-func (x *VoteRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type VoteResponse struct {
+type GroupStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The floating IP groups currently up on the member
+	Groups []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *VoteResponse) Reset() {
-	*x = VoteResponse{}
+func (x *GroupStatusResponse) Reset() {
+	*x = GroupStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[23]
+		mi := &file_rpc_pulse_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VoteResponse) String() string {
+func (x *GroupStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteResponse) ProtoMessage() {}
+func (*GroupStatusResponse) ProtoMessage() {}
 
-func (x *VoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[23]
+func (x *GroupStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,61 +2151,59 @@ func (x *VoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
-func (*VoteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GroupStatusResponse.ProtoReflect.Descriptor instead.
+func (*GroupStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *VoteResponse) GetSuccess() bool {
+func (x *GroupStatusResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *VoteResponse) GetMessage() string {
+func (x *GroupStatusResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *VoteResponse) GetErrorCode() int32 {
+func (x *GroupStatusResponse) GetGroups() []string {
 	if x != nil {
-		return x.ErrorCode
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-type MemberlistMember struct {
+type MakePassiveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hostname     string              `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Status       MemberStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=proto.MemberStatus_Status" json:"status,omitempty"`
-	LastReceived string              `protobuf:"bytes,3,opt,name=lastReceived,proto3" json:"lastReceived,omitempty"`
-	Latency      string              `protobuf:"bytes,4,opt,name=latency,proto3" json:"latency,omitempty"`
-	Score        int32               `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Message   string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *MemberlistMember) Reset() {
-	*x = MemberlistMember{}
+func (x *MakePassiveRequest) Reset() {
+	*x = MakePassiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[24]
+		mi := &file_rpc_pulse_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MemberlistMember) String() string {
+func (x *MakePassiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberlistMember) ProtoMessage() {}
+func (*MakePassiveRequest) ProtoMessage() {}
 
-func (x *MemberlistMember) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[24]
+func (x *MakePassiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,71 +2214,59 @@ func (x *MemberlistMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberlistMember.ProtoReflect.Descriptor instead.
-func (*MemberlistMember) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use MakePassiveRequest.ProtoReflect.Descriptor instead.
+func (*MakePassiveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MemberlistMember) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *MemberlistMember) GetStatus() MemberStatus_Status {
-	if x != nil {
-		return x.Status
-	}
-	return MemberStatus_ACTIVE
-}
-
-func (x *MemberlistMember) GetLastReceived() string {
+func (x *MakePassiveRequest) GetMessage() string {
 	if x != nil {
-		return x.LastReceived
+		return x.Message
 	}
 	return ""
 }
 
-func (x *MemberlistMember) GetLatency() string {
+func (x *MakePassiveRequest) GetMember() string {
 	if x != nil {
-		return x.Latency
+		return x.Member
 	}
 	return ""
 }
 
-func (x *MemberlistMember) GetScore() int32 {
+func (x *MakePassiveRequest) GetErrorCode() int32 {
 	if x != nil {
-		return x.Score
+		return x.ErrorCode
 	}
 	return 0
 }
 
-type MemberStatus struct {
+type MakePassiveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status MemberStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=proto.MemberStatus_Status" json:"status,omitempty"`
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *MemberStatus) Reset() {
-	*x = MemberStatus{}
+func (x *MakePassiveResponse) Reset() {
+	*x = MakePassiveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[25]
+		mi := &file_rpc_pulse_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MemberStatus) String() string {
+func (x *MakePassiveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberStatus) ProtoMessage() {}
+func (*MakePassiveResponse) ProtoMessage() {}
 
-func (x *MemberStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[25]
+func (x *MakePassiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,44 +2277,59 @@ func (x *MemberStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberStatus.ProtoReflect.Descriptor instead.
-func (*MemberStatus) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MakePassiveResponse.ProtoReflect.Descriptor instead.
+func (*MakePassiveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MemberStatus) GetStatus() MemberStatus_Status {
+func (x *MakePassiveResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Status
+		return x.Success
 	}
-	return MemberStatus_ACTIVE
+	return false
 }
 
-type CreateRequest struct {
+func (x *MakePassiveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MakePassiveResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type UpIpRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BindIp   string `protobuf:"bytes,1,opt,name=bind_ip,json=bindIp,proto3" json:"bind_ip,omitempty"`
-	BindPort string `protobuf:"bytes,2,opt,name=bind_port,json=bindPort,proto3" json:"bind_port,omitempty"`
+	Message string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Iface   string   `protobuf:"bytes,2,opt,name=iface,proto3" json:"iface,omitempty"`
+	Ips     []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
 }
 
-func (x *CreateRequest) Reset() {
-	*x = CreateRequest{}
+func (x *UpIpRequest) Reset() {
+	*x = UpIpRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[26]
+		mi := &file_rpc_pulse_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRequest) String() string {
+func (x *UpIpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRequest) ProtoMessage() {}
+func (*UpIpRequest) ProtoMessage() {}
 
-func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[26]
+func (x *UpIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,26 +2340,33 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
-func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UpIpRequest.ProtoReflect.Descriptor instead.
+func (*UpIpRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateRequest) GetBindIp() string {
+func (x *UpIpRequest) GetMessage() string {
 	if x != nil {
-		return x.BindIp
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateRequest) GetBindPort() string {
+func (x *UpIpRequest) GetIface() string {
 	if x != nil {
-		return x.BindPort
+		return x.Iface
 	}
 	return ""
 }
 
-type CreateResponse struct {
+func (x *UpIpRequest) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+type UpIpResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1992,26 +2374,25 @@ type CreateResponse struct {
 	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
-	Token     string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *CreateResponse) Reset() {
-	*x = CreateResponse{}
+func (x *UpIpResponse) Reset() {
+	*x = UpIpResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[27]
+		mi := &file_rpc_pulse_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateResponse) String() string {
+func (x *UpIpResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResponse) ProtoMessage() {}
+func (*UpIpResponse) ProtoMessage() {}
 
-func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[27]
+func (x *UpIpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,64 +2403,59 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
-func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UpIpResponse.ProtoReflect.Descriptor instead.
+func (*UpIpResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateResponse) GetSuccess() bool {
+func (x *UpIpResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateResponse) GetMessage() string {
+func (x *UpIpResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateResponse) GetErrorCode() int32 {
+func (x *UpIpResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-func (x *CreateResponse) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-type CertRequest struct {
+type DownIpRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BindIp string `protobuf:"bytes,3,opt,name=bind_ip,json=bindIp,proto3" json:"bind_ip,omitempty"`
+	Message string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Iface   string   `protobuf:"bytes,2,opt,name=iface,proto3" json:"iface,omitempty"`
+	Ips     []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
 }
 
-func (x *CertRequest) Reset() {
-	*x = CertRequest{}
+func (x *DownIpRequest) Reset() {
+	*x = DownIpRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[28]
+		mi := &file_rpc_pulse_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CertRequest) String() string {
+func (x *DownIpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertRequest) ProtoMessage() {}
+func (*DownIpRequest) ProtoMessage() {}
 
-func (x *CertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[28]
+func (x *DownIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,19 +2466,33 @@ func (x *CertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertRequest.ProtoReflect.Descriptor instead.
-func (*CertRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DownIpRequest.ProtoReflect.Descriptor instead.
+func (*DownIpRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CertRequest) GetBindIp() string {
+func (x *DownIpRequest) GetMessage() string {
 	if x != nil {
-		return x.BindIp
+		return x.Message
 	}
 	return ""
 }
 
-type CertResponse struct {
+func (x *DownIpRequest) GetIface() string {
+	if x != nil {
+		return x.Iface
+	}
+	return ""
+}
+
+func (x *DownIpRequest) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+type DownIpResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2112,23 +2502,23 @@ type CertResponse struct {
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *CertResponse) Reset() {
-	*x = CertResponse{}
+func (x *DownIpResponse) Reset() {
+	*x = DownIpResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[29]
+		mi := &file_rpc_pulse_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CertResponse) String() string {
+func (x *DownIpResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertResponse) ProtoMessage() {}
+func (*DownIpResponse) ProtoMessage() {}
 
-func (x *CertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[29]
+func (x *DownIpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,57 +2529,59 @@ func (x *CertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertResponse.ProtoReflect.Descriptor instead.
-func (*CertResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DownIpResponse.ProtoReflect.Descriptor instead.
+func (*DownIpResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CertResponse) GetSuccess() bool {
+func (x *DownIpResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CertResponse) GetMessage() string {
+func (x *DownIpResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CertResponse) GetErrorCode() int32 {
+func (x *DownIpResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type GroupNewRequest struct {
+type LogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Message string            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Node    string            `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Level   LogsRequest_Level `protobuf:"varint,3,opt,name=level,proto3,enum=proto.LogsRequest_Level" json:"level,omitempty"`
 }
 
-func (x *GroupNewRequest) Reset() {
-	*x = GroupNewRequest{}
+func (x *LogsRequest) Reset() {
+	*x = LogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[30]
+		mi := &file_rpc_pulse_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupNewRequest) String() string {
+func (x *LogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupNewRequest) ProtoMessage() {}
+func (*LogsRequest) ProtoMessage() {}
 
-func (x *GroupNewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[30]
+func (x *LogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2200,45 +2592,59 @@ func (x *GroupNewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupNewRequest.ProtoReflect.Descriptor instead.
-func (*GroupNewRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use LogsRequest.ProtoReflect.Descriptor instead.
+func (*LogsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GroupNewRequest) GetName() string {
+func (x *LogsRequest) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-type GroupNewResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *LogsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *LogsRequest) GetLevel() LogsRequest_Level {
+	if x != nil {
+		return x.Level
+	}
+	return LogsRequest_INFO
+}
+
+type LogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *GroupNewResponse) Reset() {
-	*x = GroupNewResponse{}
+func (x *LogsResponse) Reset() {
+	*x = LogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[31]
+		mi := &file_rpc_pulse_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupNewResponse) String() string {
+func (x *LogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupNewResponse) ProtoMessage() {}
+func (*LogsResponse) ProtoMessage() {}
 
-func (x *GroupNewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[31]
+func (x *LogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2249,57 +2655,67 @@ func (x *GroupNewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupNewResponse.ProtoReflect.Descriptor instead.
-func (*GroupNewResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use LogsResponse.ProtoReflect.Descriptor instead.
+func (*LogsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GroupNewResponse) GetSuccess() bool {
+func (x *LogsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GroupNewResponse) GetMessage() string {
+func (x *LogsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GroupNewResponse) GetErrorCode() int32 {
+func (x *LogsResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type GroupDeleteRequest struct {
+type DescribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Message      string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CpuUsage     int32  `protobuf:"varint,2,opt,name=cpuUsage,proto3" json:"cpuUsage,omitempty"`
+	MemUsage     int32  `protobuf:"varint,3,opt,name=memUsage,proto3" json:"memUsage,omitempty"`
+	Uid          string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	DiskUsage    int32  `protobuf:"varint,5,opt,name=diskUsage,proto3" json:"diskUsage,omitempty"`
+	Uptime       string `protobuf:"bytes,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Status       string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Latency      string `protobuf:"bytes,8,opt,name=latency,proto3" json:"latency,omitempty"`
+	Hostname     string `protobuf:"bytes,9,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	BindAddress  string `protobuf:"bytes,10,opt,name=bindAddress,proto3" json:"bindAddress,omitempty"`
+	LastReceived string `protobuf:"bytes,11,opt,name=lastReceived,proto3" json:"lastReceived,omitempty"`
 }
 
-func (x *GroupDeleteRequest) Reset() {
-	*x = GroupDeleteRequest{}
+func (x *DescribeRequest) Reset() {
+	*x = DescribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[32]
+		mi := &file_rpc_pulse_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupDeleteRequest) String() string {
+func (x *DescribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupDeleteRequest) ProtoMessage() {}
+func (*DescribeRequest) ProtoMessage() {}
 
-func (x *GroupDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[32]
+func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2310,137 +2726,89 @@ func (x *GroupDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupDeleteRequest.ProtoReflect.Descriptor instead.
-func (*GroupDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DescribeRequest.ProtoReflect.Descriptor instead.
+func (*DescribeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GroupDeleteRequest) GetName() string {
+func (x *DescribeRequest) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-type GroupDeleteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
-}
-
-func (x *GroupDeleteResponse) Reset() {
-	*x = GroupDeleteResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GroupDeleteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GroupDeleteResponse) ProtoMessage() {}
-
-func (x *GroupDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DescribeRequest) GetCpuUsage() int32 {
+	if x != nil {
+		return x.CpuUsage
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GroupDeleteResponse.ProtoReflect.Descriptor instead.
-func (*GroupDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{33}
+	return 0
 }
 
-func (x *GroupDeleteResponse) GetSuccess() bool {
+func (x *DescribeRequest) GetMemUsage() int32 {
 	if x != nil {
-		return x.Success
+		return x.MemUsage
 	}
-	return false
+	return 0
 }
 
-func (x *GroupDeleteResponse) GetMessage() string {
+func (x *DescribeRequest) GetUid() string {
 	if x != nil {
-		return x.Message
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *GroupDeleteResponse) GetErrorCode() int32 {
+func (x *DescribeRequest) GetDiskUsage() int32 {
 	if x != nil {
-		return x.ErrorCode
+		return x.DiskUsage
 	}
 	return 0
 }
 
-type GroupAddRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ips  []string `protobuf:"bytes,2,rep,name=ips,proto3" json:"ips,omitempty"`
-}
-
-func (x *GroupAddRequest) Reset() {
-	*x = GroupAddRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DescribeRequest) GetUptime() string {
+	if x != nil {
+		return x.Uptime
 	}
+	return ""
 }
 
-func (x *GroupAddRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DescribeRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (*GroupAddRequest) ProtoMessage() {}
-
-func (x *GroupAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DescribeRequest) GetLatency() string {
+	if x != nil {
+		return x.Latency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GroupAddRequest.ProtoReflect.Descriptor instead.
-func (*GroupAddRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{34}
+func (x *DescribeRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
 }
 
-func (x *GroupAddRequest) GetName() string {
+func (x *DescribeRequest) GetBindAddress() string {
 	if x != nil {
-		return x.Name
+		return x.BindAddress
 	}
 	return ""
 }
 
-func (x *GroupAddRequest) GetIps() []string {
+func (x *DescribeRequest) GetLastReceived() string {
 	if x != nil {
-		return x.Ips
+		return x.LastReceived
 	}
-	return nil
+	return ""
 }
 
-type GroupAddResponse struct {
+type DescribeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2450,23 +2818,23 @@ type GroupAddResponse struct {
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *GroupAddResponse) Reset() {
-	*x = GroupAddResponse{}
+func (x *DescribeResponse) Reset() {
+	*x = DescribeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[35]
+		mi := &file_rpc_pulse_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupAddResponse) String() string {
+func (x *DescribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupAddResponse) ProtoMessage() {}
+func (*DescribeResponse) ProtoMessage() {}
 
-func (x *GroupAddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[35]
+func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2477,58 +2845,66 @@ func (x *GroupAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupAddResponse.ProtoReflect.Descriptor instead.
-func (*GroupAddResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DescribeResponse.ProtoReflect.Descriptor instead.
+func (*DescribeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GroupAddResponse) GetSuccess() bool {
+func (x *DescribeResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GroupAddResponse) GetMessage() string {
+func (x *DescribeResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GroupAddResponse) GetErrorCode() int32 {
+func (x *DescribeResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type GroupRemoveRequest struct {
+type VoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ips  []string `protobuf:"bytes,2,rep,name=ips,proto3" json:"ips,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The election term the candidate is campaigning in
+	Term uint64 `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	// The hostname of the candidate requesting the vote
+	Candidate string `protobuf:"bytes,4,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	// The health check score of the candidate
+	Score int32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	// Whether the candidate is only asking if it would win without starting the term
+	PreVote bool `protobuf:"varint,6,opt,name=pre_vote,json=preVote,proto3" json:"pre_vote,omitempty"`
 }
 
-func (x *GroupRemoveRequest) Reset() {
-	*x = GroupRemoveRequest{}
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[36]
+		mi := &file_rpc_pulse_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupRemoveRequest) String() string {
+func (x *VoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupRemoveRequest) ProtoMessage() {}
+func (*VoteRequest) ProtoMessage() {}
 
-func (x *GroupRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[36]
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,26 +2915,54 @@ func (x *GroupRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupRemoveRequest.ProtoReflect.Descriptor instead.
-func (*GroupRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GroupRemoveRequest) GetName() string {
+func (x *VoteRequest) GetSuccess() bool {
 	if x != nil {
-		return x.Name
+		return x.Success
+	}
+	return false
+}
+
+func (x *VoteRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GroupRemoveRequest) GetIps() []string {
+func (x *VoteRequest) GetTerm() uint64 {
 	if x != nil {
-		return x.Ips
+		return x.Term
 	}
-	return nil
+	return 0
 }
 
-type GroupRemoveResponse struct {
+func (x *VoteRequest) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *VoteRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *VoteRequest) GetPreVote() bool {
+	if x != nil {
+		return x.PreVote
+	}
+	return false
+}
+
+type VoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2566,25 +2970,29 @@ type GroupRemoveResponse struct {
 	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// Whether the vote was granted to the candidate
+	Granted bool `protobuf:"varint,4,opt,name=granted,proto3" json:"granted,omitempty"`
+	// The election term known to the voting node
+	Term uint64 `protobuf:"varint,5,opt,name=term,proto3" json:"term,omitempty"`
 }
 
-func (x *GroupRemoveResponse) Reset() {
-	*x = GroupRemoveResponse{}
+func (x *VoteResponse) Reset() {
+	*x = VoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[37]
+		mi := &file_rpc_pulse_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupRemoveResponse) String() string {
+func (x *VoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupRemoveResponse) ProtoMessage() {}
+func (*VoteResponse) ProtoMessage() {}
 
-func (x *GroupRemoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[37]
+func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,59 +3003,77 @@ func (x *GroupRemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupRemoveResponse.ProtoReflect.Descriptor instead.
-func (*GroupRemoveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GroupRemoveResponse) GetSuccess() bool {
+func (x *VoteResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GroupRemoveResponse) GetMessage() string {
+func (x *VoteResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GroupRemoveResponse) GetErrorCode() int32 {
+func (x *VoteResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type GroupAssignRequest struct {
+func (x *VoteResponse) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *VoteResponse) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+type MemberlistMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
-	Node      string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Hostname     string              `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Status       MemberStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=proto.MemberStatus_Status" json:"status,omitempty"`
+	LastReceived string              `protobuf:"bytes,3,opt,name=lastReceived,proto3" json:"lastReceived,omitempty"`
+	Latency      string              `protobuf:"bytes,4,opt,name=latency,proto3" json:"latency,omitempty"`
+	Score        int32               `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	// The config hash of the member
+	ConfigHash string `protobuf:"bytes,6,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"`
 }
 
-func (x *GroupAssignRequest) Reset() {
-	*x = GroupAssignRequest{}
+func (x *MemberlistMember) Reset() {
+	*x = MemberlistMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[38]
+		mi := &file_rpc_pulse_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupAssignRequest) String() string {
+func (x *MemberlistMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupAssignRequest) ProtoMessage() {}
+func (*MemberlistMember) ProtoMessage() {}
 
-func (x *GroupAssignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[38]
+func (x *MemberlistMember) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2658,59 +3084,78 @@ func (x *GroupAssignRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupAssignRequest.ProtoReflect.Descriptor instead.
-func (*GroupAssignRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use MemberlistMember.ProtoReflect.Descriptor instead.
+func (*MemberlistMember) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GroupAssignRequest) GetGroup() string {
+func (x *MemberlistMember) GetHostname() string {
 	if x != nil {
-		return x.Group
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *GroupAssignRequest) GetInterface() string {
+func (x *MemberlistMember) GetStatus() MemberStatus_Status {
 	if x != nil {
-		return x.Interface
+		return x.Status
+	}
+	return MemberStatus_ACTIVE
+}
+
+func (x *MemberlistMember) GetLastReceived() string {
+	if x != nil {
+		return x.LastReceived
 	}
 	return ""
 }
 
-func (x *GroupAssignRequest) GetNode() string {
+func (x *MemberlistMember) GetLatency() string {
 	if x != nil {
-		return x.Node
+		return x.Latency
 	}
 	return ""
 }
 
-type GroupAssignResponse struct {
+func (x *MemberlistMember) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MemberlistMember) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
+type MemberStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Status MemberStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=proto.MemberStatus_Status" json:"status,omitempty"`
 }
 
-func (x *GroupAssignResponse) Reset() {
-	*x = GroupAssignResponse{}
+func (x *MemberStatus) Reset() {
+	*x = MemberStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[39]
+		mi := &file_rpc_pulse_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupAssignResponse) String() string {
+func (x *MemberStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupAssignResponse) ProtoMessage() {}
+func (*MemberStatus) ProtoMessage() {}
 
-func (x *GroupAssignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[39]
+func (x *MemberStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2721,59 +3166,44 @@ func (x *GroupAssignResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupAssignResponse.ProtoReflect.Descriptor instead.
-func (*GroupAssignResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *GroupAssignResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *GroupAssignResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use MemberStatus.ProtoReflect.Descriptor instead.
+func (*MemberStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GroupAssignResponse) GetErrorCode() int32 {
+func (x *MemberStatus) GetStatus() MemberStatus_Status {
 	if x != nil {
-		return x.ErrorCode
+		return x.Status
 	}
-	return 0
+	return MemberStatus_ACTIVE
 }
 
-type GroupUnassignRequest struct {
+type CreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
-	Node      string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	BindIp   string `protobuf:"bytes,1,opt,name=bind_ip,json=bindIp,proto3" json:"bind_ip,omitempty"`
+	BindPort string `protobuf:"bytes,2,opt,name=bind_port,json=bindPort,proto3" json:"bind_port,omitempty"`
 }
 
-func (x *GroupUnassignRequest) Reset() {
-	*x = GroupUnassignRequest{}
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[40]
+		mi := &file_rpc_pulse_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupUnassignRequest) String() string {
+func (x *CreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupUnassignRequest) ProtoMessage() {}
+func (*CreateRequest) ProtoMessage() {}
 
-func (x *GroupUnassignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[40]
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2784,33 +3214,26 @@ func (x *GroupUnassignRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupUnassignRequest.ProtoReflect.Descriptor instead.
-func (*GroupUnassignRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *GroupUnassignRequest) GetGroup() string {
-	if x != nil {
-		return x.Group
-	}
-	return ""
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GroupUnassignRequest) GetInterface() string {
+func (x *CreateRequest) GetBindIp() string {
 	if x != nil {
-		return x.Interface
+		return x.BindIp
 	}
 	return ""
 }
 
-func (x *GroupUnassignRequest) GetNode() string {
+func (x *CreateRequest) GetBindPort() string {
 	if x != nil {
-		return x.Node
+		return x.BindPort
 	}
 	return ""
 }
 
-type GroupUnassignResponse struct {
+type CreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2818,25 +3241,26 @@ type GroupUnassignResponse struct {
 	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Token     string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *GroupUnassignResponse) Reset() {
-	*x = GroupUnassignResponse{}
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[41]
+		mi := &file_rpc_pulse_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupUnassignResponse) String() string {
+func (x *CreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupUnassignResponse) ProtoMessage() {}
+func (*CreateResponse) ProtoMessage() {}
 
-func (x *GroupUnassignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[41]
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2847,55 +3271,64 @@ func (x *GroupUnassignResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupUnassignResponse.ProtoReflect.Descriptor instead.
-func (*GroupUnassignResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GroupUnassignResponse) GetSuccess() bool {
+func (x *CreateResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GroupUnassignResponse) GetMessage() string {
+func (x *CreateResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GroupUnassignResponse) GetErrorCode() int32 {
+func (x *CreateResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type GroupTableRequest struct {
+func (x *CreateResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CertRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BindIp string `protobuf:"bytes,3,opt,name=bind_ip,json=bindIp,proto3" json:"bind_ip,omitempty"`
 }
 
-func (x *GroupTableRequest) Reset() {
-	*x = GroupTableRequest{}
+func (x *CertRequest) Reset() {
+	*x = CertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[42]
+		mi := &file_rpc_pulse_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupTableRequest) String() string {
+func (x *CertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupTableRequest) ProtoMessage() {}
+func (*CertRequest) ProtoMessage() {}
 
-func (x *GroupTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[42]
+func (x *CertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2906,38 +3339,45 @@ func (x *GroupTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupTableRequest.ProtoReflect.Descriptor instead.
-func (*GroupTableRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CertRequest.ProtoReflect.Descriptor instead.
+func (*CertRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{45}
 }
 
-type GroupTableResponse struct {
+func (x *CertRequest) GetBindIp() string {
+	if x != nil {
+		return x.BindIp
+	}
+	return ""
+}
+
+type CertResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Row     []*GroupRow `protobuf:"bytes,3,rep,name=row,proto3" json:"row,omitempty"`
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *GroupTableResponse) Reset() {
-	*x = GroupTableResponse{}
+func (x *CertResponse) Reset() {
+	*x = CertResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[43]
+		mi := &file_rpc_pulse_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupTableResponse) String() string {
+func (x *CertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupTableResponse) ProtoMessage() {}
+func (*CertResponse) ProtoMessage() {}
 
-func (x *GroupTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[43]
+func (x *CertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2948,60 +3388,57 @@ func (x *GroupTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupTableResponse.ProtoReflect.Descriptor instead.
-func (*GroupTableResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CertResponse.ProtoReflect.Descriptor instead.
+func (*CertResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GroupTableResponse) GetSuccess() bool {
+func (x *CertResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GroupTableResponse) GetMessage() string {
+func (x *CertResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GroupTableResponse) GetRow() []*GroupRow {
+func (x *CertResponse) GetErrorCode() int32 {
 	if x != nil {
-		return x.Row
+		return x.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-type GroupRow struct {
+type GroupNewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ip         []string `protobuf:"bytes,2,rep,name=ip,proto3" json:"ip,omitempty"`
-	Nodes      []string `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Interfaces []string `protobuf:"bytes,4,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GroupRow) Reset() {
-	*x = GroupRow{}
+func (x *GroupNewRequest) Reset() {
+	*x = GroupNewRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[44]
+		mi := &file_rpc_pulse_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupRow) String() string {
+func (x *GroupNewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupRow) ProtoMessage() {}
+func (*GroupNewRequest) ProtoMessage() {}
 
-func (x *GroupRow) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[44]
+func (x *GroupNewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3012,62 +3449,106 @@ func (x *GroupRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupRow.ProtoReflect.Descriptor instead.
-func (*GroupRow) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GroupNewRequest.ProtoReflect.Descriptor instead.
+func (*GroupNewRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GroupRow) GetName() string {
+func (x *GroupNewRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GroupRow) GetIp() []string {
+type GroupNewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *GroupNewResponse) Reset() {
+	*x = GroupNewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupNewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupNewResponse) ProtoMessage() {}
+
+func (x *GroupNewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupNewResponse.ProtoReflect.Descriptor instead.
+func (*GroupNewResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GroupNewResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Ip
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *GroupRow) GetNodes() []string {
+func (x *GroupNewResponse) GetMessage() string {
 	if x != nil {
-		return x.Nodes
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupRow) GetInterfaces() []string {
+func (x *GroupNewResponse) GetErrorCode() int32 {
 	if x != nil {
-		return x.Interfaces
+		return x.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-type StatusRequest struct {
+type GroupDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *StatusRequest) Reset() {
-	*x = StatusRequest{}
+func (x *GroupDeleteRequest) Reset() {
+	*x = GroupDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[45]
+		mi := &file_rpc_pulse_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusRequest) String() string {
+func (x *GroupDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusRequest) ProtoMessage() {}
+func (*GroupDeleteRequest) ProtoMessage() {}
 
-func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[45]
+func (x *GroupDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3078,38 +3559,45 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
-func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GroupDeleteRequest.ProtoReflect.Descriptor instead.
+func (*GroupDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{49}
 }
 
-type StatusResponse struct {
+func (x *GroupDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GroupDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Row     []*StatusRow `protobuf:"bytes,3,rep,name=row,proto3" json:"row,omitempty"`
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
+func (x *GroupDeleteResponse) Reset() {
+	*x = GroupDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[46]
+		mi := &file_rpc_pulse_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse) String() string {
+func (x *GroupDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*GroupDeleteResponse) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[46]
+func (x *GroupDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3120,62 +3608,58 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GroupDeleteResponse.ProtoReflect.Descriptor instead.
+func (*GroupDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *StatusResponse) GetSuccess() bool {
+func (x *GroupDeleteResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *StatusResponse) GetMessage() string {
+func (x *GroupDeleteResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *StatusResponse) GetRow() []*StatusRow {
+func (x *GroupDeleteResponse) GetErrorCode() int32 {
 	if x != nil {
-		return x.Row
+		return x.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-type StatusRow struct {
+type GroupAddRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hostname     string              `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ip           string              `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Latency      string              `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
-	Status       MemberStatus_Status `protobuf:"varint,4,opt,name=status,proto3,enum=proto.MemberStatus_Status" json:"status,omitempty"`
-	LastReceived string              `protobuf:"bytes,5,opt,name=lastReceived,proto3" json:"lastReceived,omitempty"`
-	Score        int32               `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ips  []string `protobuf:"bytes,2,rep,name=ips,proto3" json:"ips,omitempty"`
 }
 
-func (x *StatusRow) Reset() {
-	*x = StatusRow{}
+func (x *GroupAddRequest) Reset() {
+	*x = GroupAddRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[47]
+		mi := &file_rpc_pulse_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusRow) String() string {
+func (x *GroupAddRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusRow) ProtoMessage() {}
+func (*GroupAddRequest) ProtoMessage() {}
 
-func (x *StatusRow) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[47]
+func (x *GroupAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3186,79 +3670,114 @@ func (x *StatusRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusRow.ProtoReflect.Descriptor instead.
-func (*StatusRow) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GroupAddRequest.ProtoReflect.Descriptor instead.
+func (*GroupAddRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *StatusRow) GetHostname() string {
+func (x *GroupAddRequest) GetName() string {
 	if x != nil {
-		return x.Hostname
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StatusRow) GetIp() string {
+func (x *GroupAddRequest) GetIps() []string {
 	if x != nil {
-		return x.Ip
+		return x.Ips
 	}
-	return ""
+	return nil
 }
 
-func (x *StatusRow) GetLatency() string {
-	if x != nil {
-		return x.Latency
+type GroupAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *GroupAddResponse) Reset() {
+	*x = GroupAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StatusRow) GetStatus() MemberStatus_Status {
+func (x *GroupAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAddResponse) ProtoMessage() {}
+
+func (x *GroupAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAddResponse.ProtoReflect.Descriptor instead.
+func (*GroupAddResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GroupAddResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Status
+		return x.Success
 	}
-	return MemberStatus_ACTIVE
+	return false
 }
 
-func (x *StatusRow) GetLastReceived() string {
+func (x *GroupAddResponse) GetMessage() string {
 	if x != nil {
-		return x.LastReceived
+		return x.Message
 	}
 	return ""
 }
 
-func (x *StatusRow) GetScore() int32 {
+func (x *GroupAddResponse) GetErrorCode() int32 {
 	if x != nil {
-		return x.Score
+		return x.ErrorCode
 	}
 	return 0
 }
 
-type ConfigRequest struct {
+type GroupRemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ips  []string `protobuf:"bytes,2,rep,name=ips,proto3" json:"ips,omitempty"`
 }
 
-func (x *ConfigRequest) Reset() {
-	*x = ConfigRequest{}
+func (x *GroupRemoveRequest) Reset() {
+	*x = GroupRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[48]
+		mi := &file_rpc_pulse_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigRequest) String() string {
+func (x *GroupRemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigRequest) ProtoMessage() {}
+func (*GroupRemoveRequest) ProtoMessage() {}
 
-func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[48]
+func (x *GroupRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3269,26 +3788,26 @@ func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigRequest.ProtoReflect.Descriptor instead.
-func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use GroupRemoveRequest.ProtoReflect.Descriptor instead.
+func (*GroupRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ConfigRequest) GetKey() string {
+func (x *GroupRemoveRequest) GetName() string {
 	if x != nil {
-		return x.Key
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ConfigRequest) GetValue() string {
+func (x *GroupRemoveRequest) GetIps() []string {
 	if x != nil {
-		return x.Value
+		return x.Ips
 	}
-	return ""
+	return nil
 }
 
-type ConfigResponse struct {
+type GroupRemoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3298,23 +3817,23 @@ type ConfigResponse struct {
 	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *ConfigResponse) Reset() {
-	*x = ConfigResponse{}
+func (x *GroupRemoveResponse) Reset() {
+	*x = GroupRemoveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[49]
+		mi := &file_rpc_pulse_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigResponse) String() string {
+func (x *GroupRemoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigResponse) ProtoMessage() {}
+func (*GroupRemoveResponse) ProtoMessage() {}
 
-func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[49]
+func (x *GroupRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3325,55 +3844,59 @@ func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigResponse.ProtoReflect.Descriptor instead.
-func (*ConfigResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GroupRemoveResponse.ProtoReflect.Descriptor instead.
+func (*GroupRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ConfigResponse) GetSuccess() bool {
+func (x *GroupRemoveResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ConfigResponse) GetMessage() string {
+func (x *GroupRemoveResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ConfigResponse) GetErrorCode() int32 {
+func (x *GroupRemoveResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type TokenRequest struct {
+type GroupAssignRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Node      string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
 }
 
-func (x *TokenRequest) Reset() {
-	*x = TokenRequest{}
+func (x *GroupAssignRequest) Reset() {
+	*x = GroupAssignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[50]
+		mi := &file_rpc_pulse_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenRequest) String() string {
+func (x *GroupAssignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenRequest) ProtoMessage() {}
+func (*GroupAssignRequest) ProtoMessage() {}
 
-func (x *TokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[50]
+func (x *GroupAssignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3384,39 +3907,59 @@ func (x *TokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenRequest.ProtoReflect.Descriptor instead.
-func (*TokenRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GroupAssignRequest.ProtoReflect.Descriptor instead.
+func (*GroupAssignRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{55}
 }
 
-type TokenResponse struct {
+func (x *GroupAssignRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GroupAssignRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *GroupAssignRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type GroupAssignResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Token     string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
-	ErrorCode int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 }
 
-func (x *TokenResponse) Reset() {
-	*x = TokenResponse{}
+func (x *GroupAssignResponse) Reset() {
+	*x = GroupAssignResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[51]
+		mi := &file_rpc_pulse_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenResponse) String() string {
+func (x *GroupAssignResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenResponse) ProtoMessage() {}
+func (*GroupAssignResponse) ProtoMessage() {}
 
-func (x *TokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[51]
+func (x *GroupAssignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3427,67 +3970,59 @@ func (x *TokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
-func (*TokenResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GroupAssignResponse.ProtoReflect.Descriptor instead.
+func (*GroupAssignResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *TokenResponse) GetSuccess() bool {
+func (x *GroupAssignResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *TokenResponse) GetMessage() string {
+func (x *GroupAssignResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *TokenResponse) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *TokenResponse) GetErrorCode() int32 {
+func (x *GroupAssignResponse) GetErrorCode() int32 {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return 0
 }
 
-type PulseNetwork struct {
+type GroupUnassignRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Action    string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-	ErrorCode int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Node      string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
 }
 
-func (x *PulseNetwork) Reset() {
-	*x = PulseNetwork{}
+func (x *GroupUnassignRequest) Reset() {
+	*x = GroupUnassignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pulse_proto_msgTypes[52]
+		mi := &file_rpc_pulse_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PulseNetwork) String() string {
+func (x *GroupUnassignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PulseNetwork) ProtoMessage() {}
+func (*GroupUnassignRequest) ProtoMessage() {}
 
-func (x *PulseNetwork) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pulse_proto_msgTypes[52]
+func (x *GroupUnassignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3498,655 +4033,3739 @@ func (x *PulseNetwork) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PulseNetwork.ProtoReflect.Descriptor instead.
-func (*PulseNetwork) Descriptor() ([]byte, []int) {
-	return file_rpc_pulse_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GroupUnassignRequest.ProtoReflect.Descriptor instead.
+func (*GroupUnassignRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *PulseNetwork) GetSuccess() bool {
+func (x *GroupUnassignRequest) GetGroup() string {
 	if x != nil {
-		return x.Success
+		return x.Group
 	}
-	return false
+	return ""
 }
 
-func (x *PulseNetwork) GetMessage() string {
+func (x *GroupUnassignRequest) GetInterface() string {
 	if x != nil {
-		return x.Message
+		return x.Interface
 	}
 	return ""
 }
 
-func (x *PulseNetwork) GetAction() string {
+func (x *GroupUnassignRequest) GetNode() string {
 	if x != nil {
-		return x.Action
+		return x.Node
 	}
 	return ""
 }
 
-func (x *PulseNetwork) GetErrorCode() int32 {
-	if x != nil {
-		return x.ErrorCode
-	}
-	return 0
-}
+type GroupUnassignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var File_rpc_pulse_proto protoreflect.FileDescriptor
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
 
-var file_rpc_pulse_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x12, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
-	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0a, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x62, 0x69, 0x6e, 0x64, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x64, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x5f, 0x63, 0x72, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x43, 0x72, 0x74, 0x12, 0x15, 0x0a,
-	0x06, 0x63, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
-	0x61, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x5f, 0x63, 0x72, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x43, 0x72, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x4b, 0x65,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22,
-	0x65, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x64,
-	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x61, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x7e, 0x0a,
-	0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x60, 0x0a,
-	0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22,
-	0x63, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x22, 0x64, 0x0a, 0x12, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x4d, 0x61,
-	0x6b, 0x65, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x22, 0x4f, 0x0a, 0x0b, 0x55, 0x70, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x66, 0x61,
-	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x03, 0x69, 0x70, 0x73, 0x22, 0x60, 0x0a, 0x0c, 0x55, 0x70, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x49, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x69, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x62, 0x0a, 0x0e, 0x44, 0x6f, 0x77,
-	0x6e, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xa1, 0x01,
-	0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x34, 0x0a, 0x05, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10,
-	0x03, 0x22, 0x60, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x22, 0xbf, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x41, 0x0a, 0x0b, 0x56,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60,
-	0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0xb6, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x71,
-	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49,
-	0x56, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x45, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12,
-	0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x53, 0x50, 0x49, 0x43, 0x49, 0x4f, 0x55, 0x53, 0x10, 0x04, 0x12,
-	0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12,
-	0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10,
-	0x06, 0x22, 0x45, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x64, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x62,
-	0x69, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x62, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x78, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0x26, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x64, 0x49, 0x70, 0x22, 0x60, 0x0a, 0x0c, 0x43, 0x65,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x25, 0x0a, 0x0f,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x0f,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x0a, 0x12, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x67, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0x5c, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x67,
-	0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x5e, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x69, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x77, 0x52,
-	0x03, 0x72, 0x6f, 0x77, 0x22, 0x64, 0x0a, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x77,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x6f, 0x77,
-	0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0xbf, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12,
-	0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x62, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x78, 0x0a,
-	0x0c, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x32, 0xb4, 0x08, 0x0a, 0x03, 0x43, 0x4c, 0x49, 0x12,
-	0x2f, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x50,
-	0x41, 0x64, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x50, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x6e, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x6e,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x35, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x74, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x50, 0x75, 0x6c, 0x73, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x1a, 0x13, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x3b, 0x0a, 0x08, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8d,
-	0x05, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x41, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x18,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x13, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
-	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4d, 0x61, 0x6b, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x50,
-	0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
-	0x0a, 0x09, 0x42, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x49, 0x50, 0x12, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x42, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x77,
-	0x6e, 0x49, 0x50, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
-	0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2f, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06,
-	0x5a, 0x04, 0x72, 0x70, 0x63, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GroupUnassignResponse) Reset() {
+	*x = GroupUnassignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_rpc_pulse_proto_rawDescOnce sync.Once
-	file_rpc_pulse_proto_rawDescData = file_rpc_pulse_proto_rawDesc
-)
+func (x *GroupUnassignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_rpc_pulse_proto_rawDescGZIP() []byte {
-	file_rpc_pulse_proto_rawDescOnce.Do(func() {
-		file_rpc_pulse_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_pulse_proto_rawDescData)
-	})
-	return file_rpc_pulse_proto_rawDescData
+func (*GroupUnassignResponse) ProtoMessage() {}
+
+func (x *GroupUnassignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_rpc_pulse_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rpc_pulse_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
-var file_rpc_pulse_proto_goTypes = []interface{}{
-	(LogsRequest_Level)(0),        // 0: proto.LogsRequest.Level
-	(MemberStatus_Status)(0),      // 1: proto.MemberStatus.Status
-	(*HealthCheckRequest)(nil),    // 2: proto.HealthCheckRequest
-	(*HealthCheckResponse)(nil),   // 3: proto.HealthCheckResponse
-	(*JoinRequest)(nil),           // 4: proto.JoinRequest
-	(*JoinResponse)(nil),          // 5: proto.JoinResponse
-	(*ConfigSyncRequest)(nil),     // 6: proto.ConfigSyncRequest
-	(*ConfigSyncResponse)(nil),    // 7: proto.ConfigSyncResponse
-	(*LeaveRequest)(nil),          // 8: proto.LeaveRequest
-	(*LeaveResponse)(nil),         // 9: proto.LeaveResponse
-	(*RemoveRequest)(nil),         // 10: proto.RemoveRequest
-	(*RemoveResponse)(nil),        // 11: proto.RemoveResponse
-	(*PromoteRequest)(nil),        // 12: proto.PromoteRequest
-	(*PromoteResponse)(nil),       // 13: proto.PromoteResponse
-	(*MakePassiveRequest)(nil),    // 14: proto.MakePassiveRequest
-	(*MakePassiveResponse)(nil),   // 15: proto.MakePassiveResponse
-	(*UpIpRequest)(nil),           // 16: proto.UpIpRequest
-	(*UpIpResponse)(nil),          // 17: proto.UpIpResponse
-	(*DownIpRequest)(nil),         // 18: proto.DownIpRequest
-	(*DownIpResponse)(nil),        // 19: proto.DownIpResponse
-	(*LogsRequest)(nil),           // 20: proto.LogsRequest
-	(*LogsResponse)(nil),          // 21: proto.LogsResponse
-	(*DescribeRequest)(nil),       // 22: proto.DescribeRequest
-	(*DescribeResponse)(nil),      // 23: proto.DescribeResponse
-	(*VoteRequest)(nil),           // 24: proto.VoteRequest
-	(*VoteResponse)(nil),          // 25: proto.VoteResponse
-	(*MemberlistMember)(nil),      // 26: proto.MemberlistMember
-	(*MemberStatus)(nil),          // 27: proto.MemberStatus
-	(*CreateRequest)(nil),         // 28: proto.CreateRequest
-	(*CreateResponse)(nil),        // 29: proto.CreateResponse
-	(*CertRequest)(nil),           // 30: proto.CertRequest
-	(*CertResponse)(nil),          // 31: proto.CertResponse
-	(*GroupNewRequest)(nil),       // 32: proto.GroupNewRequest
-	(*GroupNewResponse)(nil),      // 33: proto.GroupNewResponse
-	(*GroupDeleteRequest)(nil),    // 34: proto.GroupDeleteRequest
-	(*GroupDeleteResponse)(nil),   // 35: proto.GroupDeleteResponse
-	(*GroupAddRequest)(nil),       // 36: proto.GroupAddRequest
-	(*GroupAddResponse)(nil),      // 37: proto.GroupAddResponse
-	(*GroupRemoveRequest)(nil),    // 38: proto.GroupRemoveRequest
-	(*GroupRemoveResponse)(nil),   // 39: proto.GroupRemoveResponse
-	(*GroupAssignRequest)(nil),    // 40: proto.GroupAssignRequest
-	(*GroupAssignResponse)(nil),   // 41: proto.GroupAssignResponse
-	(*GroupUnassignRequest)(nil),  // 42: proto.GroupUnassignRequest
-	(*GroupUnassignResponse)(nil), // 43: proto.GroupUnassignResponse
-	(*GroupTableRequest)(nil),     // 44: proto.GroupTableRequest
-	(*GroupTableResponse)(nil),    // 45: proto.GroupTableResponse
-	(*GroupRow)(nil),              // 46: proto.GroupRow
-	(*StatusRequest)(nil),         // 47: proto.StatusRequest
-	(*StatusResponse)(nil),        // 48: proto.StatusResponse
-	(*StatusRow)(nil),             // 49: proto.StatusRow
-	(*ConfigRequest)(nil),         // 50: proto.ConfigRequest
-	(*ConfigResponse)(nil),        // 51: proto.ConfigResponse
-	(*TokenRequest)(nil),          // 52: proto.TokenRequest
-	(*TokenResponse)(nil),         // 53: proto.TokenResponse
-	(*PulseNetwork)(nil),          // 54: proto.PulseNetwork
+// Deprecated: Use GroupUnassignResponse.ProtoReflect.Descriptor instead.
+func (*GroupUnassignResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{58}
 }
-var file_rpc_pulse_proto_depIdxs = []int32{
-	26, // 0: proto.HealthCheckRequest.memberlist:type_name -> proto.MemberlistMember
-	0,  // 1: proto.LogsRequest.level:type_name -> proto.LogsRequest.Level
-	1,  // 2: proto.MemberlistMember.status:type_name -> proto.MemberStatus.Status
-	1,  // 3: proto.MemberStatus.status:type_name -> proto.MemberStatus.Status
-	46, // 4: proto.GroupTableResponse.row:type_name -> proto.GroupRow
-	49, // 5: proto.StatusResponse.row:type_name -> proto.StatusRow
-	1,  // 6: proto.StatusRow.status:type_name -> proto.MemberStatus.Status
-	4,  // 7: proto.CLI.Join:input_type -> proto.JoinRequest
-	8,  // 8: proto.CLI.Leave:input_type -> proto.LeaveRequest
-	10, // 9: proto.CLI.Remove:input_type -> proto.RemoveRequest
-	28, // 10: proto.CLI.Create:input_type -> proto.CreateRequest
-	30, // 11: proto.CLI.TLS:input_type -> proto.CertRequest
-	32, // 12: proto.CLI.NewGroup:input_type -> proto.GroupNewRequest
-	34, // 13: proto.CLI.DeleteGroup:input_type -> proto.GroupDeleteRequest
-	36, // 14: proto.CLI.GroupIPAdd:input_type -> proto.GroupAddRequest
-	38, // 15: proto.CLI.GroupIPRemove:input_type -> proto.GroupRemoveRequest
-	40, // 16: proto.CLI.GroupAssign:input_type -> proto.GroupAssignRequest
-	42, // 17: proto.CLI.GroupUnassign:input_type -> proto.GroupUnassignRequest
-	44, // 18: proto.CLI.GroupList:input_type -> proto.GroupTableRequest
-	47, // 19: proto.CLI.Status:input_type -> proto.StatusRequest
-	12, // 20: proto.CLI.Promote:input_type -> proto.PromoteRequest
-	50, // 21: proto.CLI.Config:input_type -> proto.ConfigRequest
-	52, // 22: proto.CLI.Token:input_type -> proto.TokenRequest
-	54, // 23: proto.CLI.Network:input_type -> proto.PulseNetwork
-	22, // 24: proto.CLI.Describe:input_type -> proto.DescribeRequest
-	2,  // 25: proto.Server.HealthCheck:input_type -> proto.HealthCheckRequest
-	4,  // 26: proto.Server.Join:input_type -> proto.JoinRequest
-	6,  // 27: proto.Server.ConfigSync:input_type -> proto.ConfigSyncRequest
-	8,  // 28: proto.Server.Leave:input_type -> proto.LeaveRequest
-	10, // 29: proto.Server.Remove:input_type -> proto.RemoveRequest
-	12, // 30: proto.Server.Promote:input_type -> proto.PromoteRequest
-	14, // 31: proto.Server.MakePassive:input_type -> proto.MakePassiveRequest
-	16, // 32: proto.Server.BringUpIP:input_type -> proto.UpIpRequest
-	18, // 33: proto.Server.BringDownIP:input_type -> proto.DownIpRequest
-	20, // 34: proto.Server.Logs:input_type -> proto.LogsRequest
-	22, // 35: proto.Server.Describe:input_type -> proto.DescribeRequest
-	5,  // 36: proto.CLI.Join:output_type -> proto.JoinResponse
-	9,  // 37: proto.CLI.Leave:output_type -> proto.LeaveResponse
-	11, // 38: proto.CLI.Remove:output_type -> proto.RemoveResponse
-	29, // 39: proto.CLI.Create:output_type -> proto.CreateResponse
-	31, // 40: proto.CLI.TLS:output_type -> proto.CertResponse
-	33, // 41: proto.CLI.NewGroup:output_type -> proto.GroupNewResponse
-	35, // 42: proto.CLI.DeleteGroup:output_type -> proto.GroupDeleteResponse
-	37, // 43: proto.CLI.GroupIPAdd:output_type -> proto.GroupAddResponse
-	39, // 44: proto.CLI.GroupIPRemove:output_type -> proto.GroupRemoveResponse
-	41, // 45: proto.CLI.GroupAssign:output_type -> proto.GroupAssignResponse
-	43, // 46: proto.CLI.GroupUnassign:output_type -> proto.GroupUnassignResponse
-	45, // 47: proto.CLI.GroupList:output_type -> proto.GroupTableResponse
-	48, // 48: proto.CLI.Status:output_type -> proto.StatusResponse
-	13, // 49: proto.CLI.Promote:output_type -> proto.PromoteResponse
-	51, // 50: proto.CLI.Config:output_type -> proto.ConfigResponse
-	53, // 51: proto.CLI.Token:output_type -> proto.TokenResponse
-	54, // 52: proto.CLI.Network:output_type -> proto.PulseNetwork
-	23, // 53: proto.CLI.Describe:output_type -> proto.DescribeResponse
-	3,  // 54: proto.Server.HealthCheck:output_type -> proto.HealthCheckResponse
-	5,  // 55: proto.Server.Join:output_type -> proto.JoinResponse
-	7,  // 56: proto.Server.ConfigSync:output_type -> proto.ConfigSyncResponse
-	9,  // 57: proto.Server.Leave:output_type -> proto.LeaveResponse
-	11, // 58: proto.Server.Remove:output_type -> proto.RemoveResponse
-	13, // 59: proto.Server.Promote:output_type -> proto.PromoteResponse
-	15, // 60: proto.Server.MakePassive:output_type -> proto.MakePassiveResponse
-	17, // 61: proto.Server.BringUpIP:output_type -> proto.UpIpResponse
-	19, // 62: proto.Server.BringDownIP:output_type -> proto.DownIpResponse
-	21, // 63: proto.Server.Logs:output_type -> proto.LogsResponse
-	23, // 64: proto.Server.Describe:output_type -> proto.DescribeResponse
-	36, // [36:65] is the sub-list for method output_type
-	7,  // [7:36] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+func (x *GroupUnassignResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func init() { file_rpc_pulse_proto_init() }
-func file_rpc_pulse_proto_init() {
-	if File_rpc_pulse_proto != nil {
-		return
+func (x *GroupUnassignResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_rpc_pulse_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckRequest); i {
+	return ""
+}
+
+func (x *GroupUnassignResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type GroupTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GroupTableRequest) Reset() {
+	*x = GroupTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTableRequest) ProtoMessage() {}
+
+func (x *GroupTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupTableRequest.ProtoReflect.Descriptor instead.
+func (*GroupTableRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{59}
+}
+
+type GroupTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Row     []*GroupRow `protobuf:"bytes,3,rep,name=row,proto3" json:"row,omitempty"`
+}
+
+func (x *GroupTableResponse) Reset() {
+	*x = GroupTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTableResponse) ProtoMessage() {}
+
+func (x *GroupTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupTableResponse.ProtoReflect.Descriptor instead.
+func (*GroupTableResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GroupTableResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GroupTableResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GroupTableResponse) GetRow() []*GroupRow {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type GroupRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ip         []string `protobuf:"bytes,2,rep,name=ip,proto3" json:"ip,omitempty"`
+	Nodes      []string `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Interfaces []string `protobuf:"bytes,4,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	// The node currently holding the group
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The node the group is placed on. Empty when the group follows the active node
+	Placement string `protobuf:"bytes,6,opt,name=placement,proto3" json:"placement,omitempty"`
+}
+
+func (x *GroupRow) Reset() {
+	*x = GroupRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRow) ProtoMessage() {}
+
+func (x *GroupRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRow.ProtoReflect.Descriptor instead.
+func (*GroupRow) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GroupRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupRow) GetIp() []string {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *GroupRow) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GroupRow) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *GroupRow) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GroupRow) GetPlacement() string {
+	if x != nil {
+		return x.Placement
+	}
+	return ""
+}
+
+type GroupPlaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// The node to place the group on. Empty to follow the active node
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *GroupPlaceRequest) Reset() {
+	*x = GroupPlaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPlaceRequest) ProtoMessage() {}
+
+func (x *GroupPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPlaceRequest.ProtoReflect.Descriptor instead.
+func (*GroupPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GroupPlaceRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GroupPlaceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type GroupPlaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *GroupPlaceResponse) Reset() {
+	*x = GroupPlaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupPlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPlaceResponse) ProtoMessage() {}
+
+func (x *GroupPlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPlaceResponse.ProtoReflect.Descriptor instead.
+func (*GroupPlaceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GroupPlaceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GroupPlaceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GroupPlaceResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type StatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{64}
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Row     []*StatusRow `protobuf:"bytes,3,rep,name=row,proto3" json:"row,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *StatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetRow() []*StatusRow {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type StatusRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname     string              `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ip           string              `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Latency      string              `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	Status       MemberStatus_Status `protobuf:"varint,4,opt,name=status,proto3,enum=proto.MemberStatus_Status" json:"status,omitempty"`
+	LastReceived string              `protobuf:"bytes,5,opt,name=lastReceived,proto3" json:"lastReceived,omitempty"`
+	Score        int32               `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	// The floating IP groups held by the node
+	Groups []string `protobuf:"bytes,7,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The members the node can reach (mesh health checks only)
+	Reachable []string `protobuf:"bytes,8,rep,name=reachable,proto3" json:"reachable,omitempty"`
+	// The status of each heartbeat path to the node
+	Paths []*PathStatus `protobuf:"bytes,9,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Whether the config of the node matches ours (in sync or drift)
+	Config string `protobuf:"bytes,10,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *StatusRow) Reset() {
+	*x = StatusRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRow) ProtoMessage() {}
+
+func (x *StatusRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRow.ProtoReflect.Descriptor instead.
+func (*StatusRow) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StatusRow) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *StatusRow) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *StatusRow) GetLatency() string {
+	if x != nil {
+		return x.Latency
+	}
+	return ""
+}
+
+func (x *StatusRow) GetStatus() MemberStatus_Status {
+	if x != nil {
+		return x.Status
+	}
+	return MemberStatus_ACTIVE
+}
+
+func (x *StatusRow) GetLastReceived() string {
+	if x != nil {
+		return x.LastReceived
+	}
+	return ""
+}
+
+func (x *StatusRow) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *StatusRow) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *StatusRow) GetReachable() []string {
+	if x != nil {
+		return x.Reachable
+	}
+	return nil
+}
+
+func (x *StatusRow) GetPaths() []*PathStatus {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *StatusRow) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type PathStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Up      bool   `protobuf:"varint,2,opt,name=up,proto3" json:"up,omitempty"`
+	Latency string `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *PathStatus) Reset() {
+	*x = PathStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathStatus) ProtoMessage() {}
+
+func (x *PathStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathStatus.ProtoReflect.Descriptor instead.
+func (*PathStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PathStatus) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PathStatus) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+func (x *PathStatus) GetLatency() string {
+	if x != nil {
+		return x.Latency
+	}
+	return ""
+}
+
+type TasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TasksRequest) Reset() {
+	*x = TasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasksRequest) ProtoMessage() {}
+
+func (x *TasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TasksRequest.ProtoReflect.Descriptor instead.
+func (*TasksRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{68}
+}
+
+type TasksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool       `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Row     []*TaskRow `protobuf:"bytes,3,rep,name=row,proto3" json:"row,omitempty"`
+}
+
+func (x *TasksResponse) Reset() {
+	*x = TasksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasksResponse) ProtoMessage() {}
+
+func (x *TasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TasksResponse.ProtoReflect.Descriptor instead.
+func (*TasksResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TasksResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TasksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TasksResponse) GetRow() []*TaskRow {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type TaskRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The interval between each run
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Started  string `protobuf:"bytes,3,opt,name=started,proto3" json:"started,omitempty"`
+	Runs     uint64 `protobuf:"varint,4,opt,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *TaskRow) Reset() {
+	*x = TaskRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRow) ProtoMessage() {}
+
+func (x *TaskRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRow.ProtoReflect.Descriptor instead.
+func (*TaskRow) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TaskRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskRow) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *TaskRow) GetStarted() string {
+	if x != nil {
+		return x.Started
+	}
+	return ""
+}
+
+func (x *TaskRow) GetRuns() uint64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+type NodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The node setting to change e.g. monitoring
+	Action   string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *NodeRequest) Reset() {
+	*x = NodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRequest) ProtoMessage() {}
+
+func (x *NodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRequest.ProtoReflect.Descriptor instead.
+func (*NodeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *NodeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *NodeRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *NodeRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type NodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *NodeResponse) Reset() {
+	*x = NodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeResponse) ProtoMessage() {}
+
+func (x *NodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeResponse.ProtoReflect.Descriptor instead.
+func (*NodeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *NodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NodeResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type MaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The node to update. Empty for the local node
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+}
+
+func (x *MaintenanceRequest) Reset() {
+	*x = MaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceRequest) ProtoMessage() {}
+
+func (x *MaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*MaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MaintenanceRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MaintenanceRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type MaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *MaintenanceResponse) Reset() {
+	*x = MaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceResponse) ProtoMessage() {}
+
+func (x *MaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*MaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MaintenanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MaintenanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MaintenanceResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type ConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ConfigRequest) Reset() {
+	*x = ConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRequest) ProtoMessage() {}
+
+func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRequest.ProtoReflect.Descriptor instead.
+func (*ConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ConfigRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *ConfigResponse) Reset() {
+	*x = ConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigResponse) ProtoMessage() {}
+
+func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigResponse.ProtoReflect.Descriptor instead.
+func (*ConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type ConfigVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigVersionsRequest) Reset() {
+	*x = ConfigVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVersionsRequest) ProtoMessage() {}
+
+func (x *ConfigVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ConfigVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{77}
+}
+
+type ConfigVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32               `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Row       []*ConfigVersionRow `protobuf:"bytes,4,rep,name=row,proto3" json:"row,omitempty"`
+}
+
+func (x *ConfigVersionsResponse) Reset() {
+	*x = ConfigVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVersionsResponse) ProtoMessage() {}
+
+func (x *ConfigVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ConfigVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ConfigVersionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigVersionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigVersionsResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *ConfigVersionsResponse) GetRow() []*ConfigVersionRow {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type ConfigVersionRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname string         `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Version  *ConfigVersion `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Why the version of the node is unknown
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ConfigVersionRow) Reset() {
+	*x = ConfigVersionRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigVersionRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVersionRow) ProtoMessage() {}
+
+func (x *ConfigVersionRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVersionRow.ProtoReflect.Descriptor instead.
+func (*ConfigVersionRow) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ConfigVersionRow) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ConfigVersionRow) GetVersion() *ConfigVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *ConfigVersionRow) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ConfigHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigHistoryRequest) Reset() {
+	*x = ConfigHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigHistoryRequest) ProtoMessage() {}
+
+func (x *ConfigHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{80}
+}
+
+type ConfigHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32              `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Row       []*ConfigBackupRow `protobuf:"bytes,4,rep,name=row,proto3" json:"row,omitempty"`
+}
+
+func (x *ConfigHistoryResponse) Reset() {
+	*x = ConfigHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigHistoryResponse) ProtoMessage() {}
+
+func (x *ConfigHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ConfigHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ConfigHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigHistoryResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *ConfigHistoryResponse) GetRow() []*ConfigBackupRow {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type ConfigBackupRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The backup number used to roll back. 1 is the newest
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// When the backup was taken in RFC3339 format
+	Time    string         `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Version *ConfigVersion `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ConfigBackupRow) Reset() {
+	*x = ConfigBackupRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigBackupRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigBackupRow) ProtoMessage() {}
+
+func (x *ConfigBackupRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigBackupRow.ProtoReflect.Descriptor instead.
+func (*ConfigBackupRow) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ConfigBackupRow) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ConfigBackupRow) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *ConfigBackupRow) GetVersion() *ConfigVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type ConfigRollbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Whether the restored config is replicated to every node
+	Replicate bool `protobuf:"varint,2,opt,name=replicate,proto3" json:"replicate,omitempty"`
+}
+
+func (x *ConfigRollbackRequest) Reset() {
+	*x = ConfigRollbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRollbackRequest) ProtoMessage() {}
+
+func (x *ConfigRollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRollbackRequest.ProtoReflect.Descriptor instead.
+func (*ConfigRollbackRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ConfigRollbackRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ConfigRollbackRequest) GetReplicate() bool {
+	if x != nil {
+		return x.Replicate
+	}
+	return false
+}
+
+type ConfigRollbackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *ConfigRollbackResponse) Reset() {
+	*x = ConfigRollbackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRollbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRollbackResponse) ProtoMessage() {}
+
+func (x *ConfigRollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRollbackResponse.ProtoReflect.Descriptor instead.
+func (*ConfigRollbackResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ConfigRollbackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigRollbackResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigRollbackResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type CertStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CertStatusRequest) Reset() {
+	*x = CertStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertStatusRequest) ProtoMessage() {}
+
+func (x *CertStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertStatusRequest.ProtoReflect.Descriptor instead.
+func (*CertStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{85}
+}
+
+type CertStatusRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hostname of the node or empty for a CA
+	Hostname string           `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Cert     *CertificateInfo `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert,omitempty"`
+	// Whether the node holds the CA key
+	CaKey bool `protobuf:"varint,3,opt,name=ca_key,json=caKey,proto3" json:"ca_key,omitempty"`
+	// The number of the node's revocation list
+	CrlNumber uint64 `protobuf:"varint,4,opt,name=crl_number,json=crlNumber,proto3" json:"crl_number,omitempty"`
+	// Why the certificate of the node couldn't be fetched
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CertStatusRow) Reset() {
+	*x = CertStatusRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertStatusRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertStatusRow) ProtoMessage() {}
+
+func (x *CertStatusRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertStatusRow.ProtoReflect.Descriptor instead.
+func (*CertStatusRow) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CertStatusRow) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *CertStatusRow) GetCert() *CertificateInfo {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *CertStatusRow) GetCaKey() bool {
+	if x != nil {
+		return x.CaKey
+	}
+	return false
+}
+
+func (x *CertStatusRow) GetCrlNumber() uint64 {
+	if x != nil {
+		return x.CrlNumber
+	}
+	return 0
+}
+
+func (x *CertStatusRow) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CertStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32            `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	Row       []*CertStatusRow `protobuf:"bytes,4,rep,name=row,proto3" json:"row,omitempty"`
+}
+
+func (x *CertStatusResponse) Reset() {
+	*x = CertStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertStatusResponse) ProtoMessage() {}
+
+func (x *CertStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertStatusResponse.ProtoReflect.Descriptor instead.
+func (*CertStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CertStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CertStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CertStatusResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *CertStatusResponse) GetRow() []*CertStatusRow {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type CertRotateCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only finish a rotation that was interrupted
+	Finish bool `protobuf:"varint,1,opt,name=finish,proto3" json:"finish,omitempty"`
+}
+
+func (x *CertRotateCARequest) Reset() {
+	*x = CertRotateCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertRotateCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertRotateCARequest) ProtoMessage() {}
+
+func (x *CertRotateCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertRotateCARequest.ProtoReflect.Descriptor instead.
+func (*CertRotateCARequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CertRotateCARequest) GetFinish() bool {
+	if x != nil {
+		return x.Finish
+	}
+	return false
+}
+
+type CertRotateCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *CertRotateCAResponse) Reset() {
+	*x = CertRotateCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertRotateCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertRotateCAResponse) ProtoMessage() {}
+
+func (x *CertRotateCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertRotateCAResponse.ProtoReflect.Descriptor instead.
+func (*CertRotateCAResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CertRotateCAResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CertRotateCAResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CertRotateCAResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type CertRevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serial number in hex of the certificate to revoke
+	Serial string `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+}
+
+func (x *CertRevokeRequest) Reset() {
+	*x = CertRevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertRevokeRequest) ProtoMessage() {}
+
+func (x *CertRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertRevokeRequest.ProtoReflect.Descriptor instead.
+func (*CertRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CertRevokeRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type CertRevokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *CertRevokeResponse) Reset() {
+	*x = CertRevokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertRevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertRevokeResponse) ProtoMessage() {}
+
+func (x *CertRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertRevokeResponse.ProtoReflect.Descriptor instead.
+func (*CertRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CertRevokeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CertRevokeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CertRevokeResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type TokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TokenRequest) Reset() {
+	*x = TokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRequest) ProtoMessage() {}
+
+func (x *TokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRequest.ProtoReflect.Descriptor instead.
+func (*TokenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{92}
+}
+
+type TokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Token     string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	ErrorCode int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *TokenResponse) Reset() {
+	*x = TokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenResponse) ProtoMessage() {}
+
+func (x *TokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
+func (*TokenResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TokenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type PulseNetwork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Action    string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	ErrorCode int32  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+}
+
+func (x *PulseNetwork) Reset() {
+	*x = PulseNetwork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pulse_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PulseNetwork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PulseNetwork) ProtoMessage() {}
+
+func (x *PulseNetwork) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pulse_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PulseNetwork.ProtoReflect.Descriptor instead.
+func (*PulseNetwork) Descriptor() ([]byte, []int) {
+	return file_rpc_pulse_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PulseNetwork) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PulseNetwork) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PulseNetwork) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PulseNetwork) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+var File_rpc_pulse_proto protoreflect.FileDescriptor
+
+var file_rpc_pulse_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x01, 0x0a, 0x12, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0a, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x4c, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0x4d, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22,
+	0xa1, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6e,
+	0x64, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x64,
+	0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x5f, 0x63, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x61, 0x43, 0x72, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x4b, 0x65, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x63, 0x73, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x5f, 0x63, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x61, 0x43, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x6c, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22,
+	0x75, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x63, 0x73, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x5f, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x74, 0x22, 0x60, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x19, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63,
+	0x65, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x63, 0x61, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x63, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63,
+	0x61, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x5f,
+	0x63, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x43, 0x72, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x65, 0x0a, 0x11,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x2e, 0x0a,
+	0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xaf, 0x01,
+	0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x64, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x61, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x7e,
+	0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8a,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x90, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x58,
+	0x0a, 0x0e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x30, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x22, 0x61, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x12, 0x4d, 0x61, 0x6b, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x67,
+	0x0a, 0x13, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4f, 0x0a, 0x0b, 0x55, 0x70, 0x49, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x60, 0x0a, 0x0c, 0x55, 0x70, 0x49, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x44, 0x6f,
+	0x77, 0x6e, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x62, 0x0a,
+	0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0x34, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45,
+	0x42, 0x55, 0x47, 0x10, 0x03, 0x22, 0x60, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbf, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x10, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xa4, 0x01, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70,
+	0x72, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x4c, 0x45, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41,
+	0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x41, 0x56, 0x41,
+	0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x53, 0x50,
+	0x49, 0x43, 0x49, 0x4f, 0x55, 0x53, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x4e, 0x49,
+	0x54, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41,
+	0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x07, 0x22, 0x45, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x69, 0x6e, 0x64, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x22, 0x78, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x26, 0x0a, 0x0b,
+	0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62,
+	0x69, 0x6e, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69,
+	0x6e, 0x64, 0x49, 0x70, 0x22, 0x60, 0x0a, 0x0c, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a,
+	0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a,
+	0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22,
+	0x64, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70,
+	0x73, 0x22, 0x67, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x5e, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x22, 0x69, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x13, 0x0a, 0x11,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x6b, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x72,
+	0x6f, 0x77, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x77, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0x98,
+	0x01, 0x0a, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x11, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x66, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x68, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x6f, 0x77, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0xb6, 0x02, 0x0a, 0x09,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x50, 0x0a, 0x0a, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x72,
+	0x6f, 0x77, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x6f, 0x77, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0x67, 0x0a,
+	0x07, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x60, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x4a, 0x0a, 0x12, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a,
+	0x13, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x62, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x95, 0x01, 0x0a,
+	0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x72, 0x6f, 0x77,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x52,
+	0x03, 0x72, 0x6f, 0x77, 0x22, 0x74, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28,
+	0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x52, 0x6f, 0x77, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0x6b, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0x6a, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x13,
+	0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x43, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x63, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63,
+	0x61, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x43, 0x65,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x6f, 0x77, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x65,
+	0x72, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x22, 0x68, 0x0a, 0x14, 0x43, 0x65, 0x72,
+	0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x22, 0x66, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x78, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x32, 0xdb, 0x0d, 0x0a, 0x03,
+	0x43, 0x4c, 0x49, 0x12, 0x2f, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x12, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x50, 0x41, 0x64, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x50, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55,
+	0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x73,
+	0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3b, 0x0a, 0x08, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x65,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0c, 0x43, 0x65, 0x72, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x41, 0x12, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbd, 0x09, 0x0a, 0x06, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x4a, 0x6f,
+	0x69, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x73, 0x73, 0x69,
+	0x76, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x42, 0x72, 0x69,
+	0x6e, 0x67, 0x55, 0x70, 0x49, 0x50, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x70, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x0b, 0x42, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x77, 0x6e, 0x49, 0x50, 0x12, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x49, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x56, 0x6f, 0x74,
+	0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x50, 0x72,
+	0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f,
+	0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x65, 0x72, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x72, 0x70, 0x63,
+	0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_pulse_proto_rawDescOnce sync.Once
+	file_rpc_pulse_proto_rawDescData = file_rpc_pulse_proto_rawDesc
+)
+
+func file_rpc_pulse_proto_rawDescGZIP() []byte {
+	file_rpc_pulse_proto_rawDescOnce.Do(func() {
+		file_rpc_pulse_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_pulse_proto_rawDescData)
+	})
+	return file_rpc_pulse_proto_rawDescData
+}
+
+var file_rpc_pulse_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rpc_pulse_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_rpc_pulse_proto_goTypes = []interface{}{
+	(LogsRequest_Level)(0),            // 0: proto.LogsRequest.Level
+	(MemberStatus_Status)(0),          // 1: proto.MemberStatus.Status
+	(*HealthCheckRequest)(nil),        // 2: proto.HealthCheckRequest
+	(*HealthCheckResponse)(nil),       // 3: proto.HealthCheckResponse
+	(*HeartbeatRequest)(nil),          // 4: proto.HeartbeatRequest
+	(*HeartbeatResponse)(nil),         // 5: proto.HeartbeatResponse
+	(*JoinRequest)(nil),               // 6: proto.JoinRequest
+	(*JoinResponse)(nil),              // 7: proto.JoinResponse
+	(*SignCertificateRequest)(nil),    // 8: proto.SignCertificateRequest
+	(*SignCertificateResponse)(nil),   // 9: proto.SignCertificateResponse
+	(*CertificateInfo)(nil),           // 10: proto.CertificateInfo
+	(*CertificateStatusRequest)(nil),  // 11: proto.CertificateStatusRequest
+	(*CertificateStatusResponse)(nil), // 12: proto.CertificateStatusResponse
+	(*CertUpdateRequest)(nil),         // 13: proto.CertUpdateRequest
+	(*CertUpdateResponse)(nil),        // 14: proto.CertUpdateResponse
+	(*ConfigSyncRequest)(nil),         // 15: proto.ConfigSyncRequest
+	(*ConfigSyncResponse)(nil),        // 16: proto.ConfigSyncResponse
+	(*ConfigVersion)(nil),             // 17: proto.ConfigVersion
+	(*ConfigVersionRequest)(nil),      // 18: proto.ConfigVersionRequest
+	(*ConfigVersionResponse)(nil),     // 19: proto.ConfigVersionResponse
+	(*LeaveRequest)(nil),              // 20: proto.LeaveRequest
+	(*LeaveResponse)(nil),             // 21: proto.LeaveResponse
+	(*RemoveRequest)(nil),             // 22: proto.RemoveRequest
+	(*RemoveResponse)(nil),            // 23: proto.RemoveResponse
+	(*PromoteRequest)(nil),            // 24: proto.PromoteRequest
+	(*PromoteResponse)(nil),           // 25: proto.PromoteResponse
+	(*SwitchoverStep)(nil),            // 26: proto.SwitchoverStep
+	(*PreflightRequest)(nil),          // 27: proto.PreflightRequest
+	(*PreflightResponse)(nil),         // 28: proto.PreflightResponse
+	(*GroupStatusRequest)(nil),        // 29: proto.GroupStatusRequest
+	(*GroupStatusResponse)(nil),       // 30: proto.GroupStatusResponse
+	(*MakePassiveRequest)(nil),        // 31: proto.MakePassiveRequest
+	(*MakePassiveResponse)(nil),       // 32: proto.MakePassiveResponse
+	(*UpIpRequest)(nil),               // 33: proto.UpIpRequest
+	(*UpIpResponse)(nil),              // 34: proto.UpIpResponse
+	(*DownIpRequest)(nil),             // 35: proto.DownIpRequest
+	(*DownIpResponse)(nil),            // 36: proto.DownIpResponse
+	(*LogsRequest)(nil),               // 37: proto.LogsRequest
+	(*LogsResponse)(nil),              // 38: proto.LogsResponse
+	(*DescribeRequest)(nil),           // 39: proto.DescribeRequest
+	(*DescribeResponse)(nil),          // 40: proto.DescribeResponse
+	(*VoteRequest)(nil),               // 41: proto.VoteRequest
+	(*VoteResponse)(nil),              // 42: proto.VoteResponse
+	(*MemberlistMember)(nil),          // 43: proto.MemberlistMember
+	(*MemberStatus)(nil),              // 44: proto.MemberStatus
+	(*CreateRequest)(nil),             // 45: proto.CreateRequest
+	(*CreateResponse)(nil),            // 46: proto.CreateResponse
+	(*CertRequest)(nil),               // 47: proto.CertRequest
+	(*CertResponse)(nil),              // 48: proto.CertResponse
+	(*GroupNewRequest)(nil),           // 49: proto.GroupNewRequest
+	(*GroupNewResponse)(nil),          // 50: proto.GroupNewResponse
+	(*GroupDeleteRequest)(nil),        // 51: proto.GroupDeleteRequest
+	(*GroupDeleteResponse)(nil),       // 52: proto.GroupDeleteResponse
+	(*GroupAddRequest)(nil),           // 53: proto.GroupAddRequest
+	(*GroupAddResponse)(nil),          // 54: proto.GroupAddResponse
+	(*GroupRemoveRequest)(nil),        // 55: proto.GroupRemoveRequest
+	(*GroupRemoveResponse)(nil),       // 56: proto.GroupRemoveResponse
+	(*GroupAssignRequest)(nil),        // 57: proto.GroupAssignRequest
+	(*GroupAssignResponse)(nil),       // 58: proto.GroupAssignResponse
+	(*GroupUnassignRequest)(nil),      // 59: proto.GroupUnassignRequest
+	(*GroupUnassignResponse)(nil),     // 60: proto.GroupUnassignResponse
+	(*GroupTableRequest)(nil),         // 61: proto.GroupTableRequest
+	(*GroupTableResponse)(nil),        // 62: proto.GroupTableResponse
+	(*GroupRow)(nil),                  // 63: proto.GroupRow
+	(*GroupPlaceRequest)(nil),         // 64: proto.GroupPlaceRequest
+	(*GroupPlaceResponse)(nil),        // 65: proto.GroupPlaceResponse
+	(*StatusRequest)(nil),             // 66: proto.StatusRequest
+	(*StatusResponse)(nil),            // 67: proto.StatusResponse
+	(*StatusRow)(nil),                 // 68: proto.StatusRow
+	(*PathStatus)(nil),                // 69: proto.PathStatus
+	(*TasksRequest)(nil),              // 70: proto.TasksRequest
+	(*TasksResponse)(nil),             // 71: proto.TasksResponse
+	(*TaskRow)(nil),                   // 72: proto.TaskRow
+	(*NodeRequest)(nil),               // 73: proto.NodeRequest
+	(*NodeResponse)(nil),              // 74: proto.NodeResponse
+	(*MaintenanceRequest)(nil),        // 75: proto.MaintenanceRequest
+	(*MaintenanceResponse)(nil),       // 76: proto.MaintenanceResponse
+	(*ConfigRequest)(nil),             // 77: proto.ConfigRequest
+	(*ConfigResponse)(nil),            // 78: proto.ConfigResponse
+	(*ConfigVersionsRequest)(nil),     // 79: proto.ConfigVersionsRequest
+	(*ConfigVersionsResponse)(nil),    // 80: proto.ConfigVersionsResponse
+	(*ConfigVersionRow)(nil),          // 81: proto.ConfigVersionRow
+	(*ConfigHistoryRequest)(nil),      // 82: proto.ConfigHistoryRequest
+	(*ConfigHistoryResponse)(nil),     // 83: proto.ConfigHistoryResponse
+	(*ConfigBackupRow)(nil),           // 84: proto.ConfigBackupRow
+	(*ConfigRollbackRequest)(nil),     // 85: proto.ConfigRollbackRequest
+	(*ConfigRollbackResponse)(nil),    // 86: proto.ConfigRollbackResponse
+	(*CertStatusRequest)(nil),         // 87: proto.CertStatusRequest
+	(*CertStatusRow)(nil),             // 88: proto.CertStatusRow
+	(*CertStatusResponse)(nil),        // 89: proto.CertStatusResponse
+	(*CertRotateCARequest)(nil),       // 90: proto.CertRotateCARequest
+	(*CertRotateCAResponse)(nil),      // 91: proto.CertRotateCAResponse
+	(*CertRevokeRequest)(nil),         // 92: proto.CertRevokeRequest
+	(*CertRevokeResponse)(nil),        // 93: proto.CertRevokeResponse
+	(*TokenRequest)(nil),              // 94: proto.TokenRequest
+	(*TokenResponse)(nil),             // 95: proto.TokenResponse
+	(*PulseNetwork)(nil),              // 96: proto.PulseNetwork
+}
+var file_rpc_pulse_proto_depIdxs = []int32{
+	43, // 0: proto.HealthCheckRequest.memberlist:type_name -> proto.MemberlistMember
+	17, // 1: proto.HealthCheckRequest.config_version:type_name -> proto.ConfigVersion
+	17, // 2: proto.HealthCheckResponse.config_version:type_name -> proto.ConfigVersion
+	10, // 3: proto.CertificateStatusResponse.cert:type_name -> proto.CertificateInfo
+	10, // 4: proto.CertificateStatusResponse.cas:type_name -> proto.CertificateInfo
+	17, // 5: proto.ConfigSyncResponse.version:type_name -> proto.ConfigVersion
+	17, // 6: proto.ConfigVersionResponse.version:type_name -> proto.ConfigVersion
+	26, // 7: proto.PromoteResponse.steps:type_name -> proto.SwitchoverStep
+	0,  // 8: proto.LogsRequest.level:type_name -> proto.LogsRequest.Level
+	1,  // 9: proto.MemberlistMember.status:type_name -> proto.MemberStatus.Status
+	1,  // 10: proto.MemberStatus.status:type_name -> proto.MemberStatus.Status
+	63, // 11: proto.GroupTableResponse.row:type_name -> proto.GroupRow
+	68, // 12: proto.StatusResponse.row:type_name -> proto.StatusRow
+	1,  // 13: proto.StatusRow.status:type_name -> proto.MemberStatus.Status
+	69, // 14: proto.StatusRow.paths:type_name -> proto.PathStatus
+	72, // 15: proto.TasksResponse.row:type_name -> proto.TaskRow
+	81, // 16: proto.ConfigVersionsResponse.row:type_name -> proto.ConfigVersionRow
+	17, // 17: proto.ConfigVersionRow.version:type_name -> proto.ConfigVersion
+	84, // 18: proto.ConfigHistoryResponse.row:type_name -> proto.ConfigBackupRow
+	17, // 19: proto.ConfigBackupRow.version:type_name -> proto.ConfigVersion
+	10, // 20: proto.CertStatusRow.cert:type_name -> proto.CertificateInfo
+	88, // 21: proto.CertStatusResponse.row:type_name -> proto.CertStatusRow
+	6,  // 22: proto.CLI.Join:input_type -> proto.JoinRequest
+	20, // 23: proto.CLI.Leave:input_type -> proto.LeaveRequest
+	22, // 24: proto.CLI.Remove:input_type -> proto.RemoveRequest
+	45, // 25: proto.CLI.Create:input_type -> proto.CreateRequest
+	47, // 26: proto.CLI.TLS:input_type -> proto.CertRequest
+	49, // 27: proto.CLI.NewGroup:input_type -> proto.GroupNewRequest
+	51, // 28: proto.CLI.DeleteGroup:input_type -> proto.GroupDeleteRequest
+	53, // 29: proto.CLI.GroupIPAdd:input_type -> proto.GroupAddRequest
+	55, // 30: proto.CLI.GroupIPRemove:input_type -> proto.GroupRemoveRequest
+	57, // 31: proto.CLI.GroupAssign:input_type -> proto.GroupAssignRequest
+	59, // 32: proto.CLI.GroupUnassign:input_type -> proto.GroupUnassignRequest
+	61, // 33: proto.CLI.GroupList:input_type -> proto.GroupTableRequest
+	64, // 34: proto.CLI.GroupPlace:input_type -> proto.GroupPlaceRequest
+	66, // 35: proto.CLI.Status:input_type -> proto.StatusRequest
+	24, // 36: proto.CLI.Promote:input_type -> proto.PromoteRequest
+	77, // 37: proto.CLI.Config:input_type -> proto.ConfigRequest
+	94, // 38: proto.CLI.Token:input_type -> proto.TokenRequest
+	96, // 39: proto.CLI.Network:input_type -> proto.PulseNetwork
+	39, // 40: proto.CLI.Describe:input_type -> proto.DescribeRequest
+	70, // 41: proto.CLI.Tasks:input_type -> proto.TasksRequest
+	73, // 42: proto.CLI.Node:input_type -> proto.NodeRequest
+	75, // 43: proto.CLI.Maintenance:input_type -> proto.MaintenanceRequest
+	79, // 44: proto.CLI.ConfigVersions:input_type -> proto.ConfigVersionsRequest
+	82, // 45: proto.CLI.ConfigHistory:input_type -> proto.ConfigHistoryRequest
+	85, // 46: proto.CLI.ConfigRollback:input_type -> proto.ConfigRollbackRequest
+	87, // 47: proto.CLI.CertStatus:input_type -> proto.CertStatusRequest
+	90, // 48: proto.CLI.CertRotateCA:input_type -> proto.CertRotateCARequest
+	92, // 49: proto.CLI.CertRevoke:input_type -> proto.CertRevokeRequest
+	2,  // 50: proto.Server.HealthCheck:input_type -> proto.HealthCheckRequest
+	6,  // 51: proto.Server.Join:input_type -> proto.JoinRequest
+	15, // 52: proto.Server.ConfigSync:input_type -> proto.ConfigSyncRequest
+	20, // 53: proto.Server.Leave:input_type -> proto.LeaveRequest
+	22, // 54: proto.Server.Remove:input_type -> proto.RemoveRequest
+	24, // 55: proto.Server.Promote:input_type -> proto.PromoteRequest
+	31, // 56: proto.Server.MakePassive:input_type -> proto.MakePassiveRequest
+	33, // 57: proto.Server.BringUpIP:input_type -> proto.UpIpRequest
+	35, // 58: proto.Server.BringDownIP:input_type -> proto.DownIpRequest
+	37, // 59: proto.Server.Logs:input_type -> proto.LogsRequest
+	39, // 60: proto.Server.Describe:input_type -> proto.DescribeRequest
+	41, // 61: proto.Server.Vote:input_type -> proto.VoteRequest
+	27, // 62: proto.Server.Preflight:input_type -> proto.PreflightRequest
+	29, // 63: proto.Server.GroupStatus:input_type -> proto.GroupStatusRequest
+	4,  // 64: proto.Server.Heartbeat:input_type -> proto.HeartbeatRequest
+	18, // 65: proto.Server.ConfigVersion:input_type -> proto.ConfigVersionRequest
+	8,  // 66: proto.Server.SignCertificate:input_type -> proto.SignCertificateRequest
+	11, // 67: proto.Server.CertificateStatus:input_type -> proto.CertificateStatusRequest
+	13, // 68: proto.Server.CertUpdate:input_type -> proto.CertUpdateRequest
+	7,  // 69: proto.CLI.Join:output_type -> proto.JoinResponse
+	21, // 70: proto.CLI.Leave:output_type -> proto.LeaveResponse
+	23, // 71: proto.CLI.Remove:output_type -> proto.RemoveResponse
+	46, // 72: proto.CLI.Create:output_type -> proto.CreateResponse
+	48, // 73: proto.CLI.TLS:output_type -> proto.CertResponse
+	50, // 74: proto.CLI.NewGroup:output_type -> proto.GroupNewResponse
+	52, // 75: proto.CLI.DeleteGroup:output_type -> proto.GroupDeleteResponse
+	54, // 76: proto.CLI.GroupIPAdd:output_type -> proto.GroupAddResponse
+	56, // 77: proto.CLI.GroupIPRemove:output_type -> proto.GroupRemoveResponse
+	58, // 78: proto.CLI.GroupAssign:output_type -> proto.GroupAssignResponse
+	60, // 79: proto.CLI.GroupUnassign:output_type -> proto.GroupUnassignResponse
+	62, // 80: proto.CLI.GroupList:output_type -> proto.GroupTableResponse
+	65, // 81: proto.CLI.GroupPlace:output_type -> proto.GroupPlaceResponse
+	67, // 82: proto.CLI.Status:output_type -> proto.StatusResponse
+	25, // 83: proto.CLI.Promote:output_type -> proto.PromoteResponse
+	78, // 84: proto.CLI.Config:output_type -> proto.ConfigResponse
+	95, // 85: proto.CLI.Token:output_type -> proto.TokenResponse
+	96, // 86: proto.CLI.Network:output_type -> proto.PulseNetwork
+	40, // 87: proto.CLI.Describe:output_type -> proto.DescribeResponse
+	71, // 88: proto.CLI.Tasks:output_type -> proto.TasksResponse
+	74, // 89: proto.CLI.Node:output_type -> proto.NodeResponse
+	76, // 90: proto.CLI.Maintenance:output_type -> proto.MaintenanceResponse
+	80, // 91: proto.CLI.ConfigVersions:output_type -> proto.ConfigVersionsResponse
+	83, // 92: proto.CLI.ConfigHistory:output_type -> proto.ConfigHistoryResponse
+	86, // 93: proto.CLI.ConfigRollback:output_type -> proto.ConfigRollbackResponse
+	89, // 94: proto.CLI.CertStatus:output_type -> proto.CertStatusResponse
+	91, // 95: proto.CLI.CertRotateCA:output_type -> proto.CertRotateCAResponse
+	93, // 96: proto.CLI.CertRevoke:output_type -> proto.CertRevokeResponse
+	3,  // 97: proto.Server.HealthCheck:output_type -> proto.HealthCheckResponse
+	7,  // 98: proto.Server.Join:output_type -> proto.JoinResponse
+	16, // 99: proto.Server.ConfigSync:output_type -> proto.ConfigSyncResponse
+	21, // 100: proto.Server.Leave:output_type -> proto.LeaveResponse
+	23, // 101: proto.Server.Remove:output_type -> proto.RemoveResponse
+	25, // 102: proto.Server.Promote:output_type -> proto.PromoteResponse
+	32, // 103: proto.Server.MakePassive:output_type -> proto.MakePassiveResponse
+	34, // 104: proto.Server.BringUpIP:output_type -> proto.UpIpResponse
+	36, // 105: proto.Server.BringDownIP:output_type -> proto.DownIpResponse
+	38, // 106: proto.Server.Logs:output_type -> proto.LogsResponse
+	40, // 107: proto.Server.Describe:output_type -> proto.DescribeResponse
+	42, // 108: proto.Server.Vote:output_type -> proto.VoteResponse
+	28, // 109: proto.Server.Preflight:output_type -> proto.PreflightResponse
+	30, // 110: proto.Server.GroupStatus:output_type -> proto.GroupStatusResponse
+	5,  // 111: proto.Server.Heartbeat:output_type -> proto.HeartbeatResponse
+	19, // 112: proto.Server.ConfigVersion:output_type -> proto.ConfigVersionResponse
+	9,  // 113: proto.Server.SignCertificate:output_type -> proto.SignCertificateResponse
+	12, // 114: proto.Server.CertificateStatus:output_type -> proto.CertificateStatusResponse
+	14, // 115: proto.Server.CertUpdate:output_type -> proto.CertUpdateResponse
+	69, // [69:116] is the sub-list for method output_type
+	22, // [22:69] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_rpc_pulse_proto_init() }
+func file_rpc_pulse_proto_init() {
+	if File_rpc_pulse_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_pulse_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchoverStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreflightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreflightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakePassiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakePassiveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpIpRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4157,8 +7776,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckResponse); i {
+		file_rpc_pulse_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpIpResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4169,8 +7788,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRequest); i {
+		file_rpc_pulse_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownIpRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4181,8 +7800,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinResponse); i {
+		file_rpc_pulse_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownIpResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4193,8 +7812,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigSyncRequest); i {
+		file_rpc_pulse_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4205,8 +7824,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigSyncResponse); i {
+		file_rpc_pulse_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4217,8 +7836,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveRequest); i {
+		file_rpc_pulse_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4229,8 +7848,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveResponse); i {
+		file_rpc_pulse_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4241,8 +7860,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveRequest); i {
+		file_rpc_pulse_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4253,8 +7872,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveResponse); i {
+		file_rpc_pulse_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4265,8 +7884,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromoteRequest); i {
+		file_rpc_pulse_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberlistMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4277,8 +7896,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromoteResponse); i {
+		file_rpc_pulse_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4289,8 +7908,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakePassiveRequest); i {
+		file_rpc_pulse_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4301,8 +7920,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakePassiveResponse); i {
+		file_rpc_pulse_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4313,8 +7932,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpIpRequest); i {
+		file_rpc_pulse_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4325,8 +7944,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpIpResponse); i {
+		file_rpc_pulse_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4337,8 +7956,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownIpRequest); i {
+		file_rpc_pulse_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupNewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4349,8 +7968,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownIpResponse); i {
+		file_rpc_pulse_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupNewResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4361,8 +7980,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogsRequest); i {
+		file_rpc_pulse_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4373,8 +7992,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogsResponse); i {
+		file_rpc_pulse_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4385,8 +8004,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeRequest); i {
+		file_rpc_pulse_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4397,8 +8016,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeResponse); i {
+		file_rpc_pulse_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4409,8 +8028,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteRequest); i {
+		file_rpc_pulse_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4421,8 +8040,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteResponse); i {
+		file_rpc_pulse_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4433,8 +8052,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberlistMember); i {
+		file_rpc_pulse_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupAssignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4445,8 +8064,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberStatus); i {
+		file_rpc_pulse_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupAssignResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4457,8 +8076,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRequest); i {
+		file_rpc_pulse_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupUnassignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4469,8 +8088,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResponse); i {
+		file_rpc_pulse_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupUnassignResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4481,8 +8100,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CertRequest); i {
+		file_rpc_pulse_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4493,8 +8112,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CertResponse); i {
+		file_rpc_pulse_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4505,8 +8124,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupNewRequest); i {
+		file_rpc_pulse_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4517,8 +8136,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupNewResponse); i {
+		file_rpc_pulse_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupPlaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4529,8 +8148,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupDeleteRequest); i {
+		file_rpc_pulse_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupPlaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4541,8 +8160,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupDeleteResponse); i {
+		file_rpc_pulse_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4553,8 +8172,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupAddRequest); i {
+		file_rpc_pulse_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4565,8 +8184,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupAddResponse); i {
+		file_rpc_pulse_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4577,8 +8196,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupRemoveRequest); i {
+		file_rpc_pulse_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4589,8 +8208,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupRemoveResponse); i {
+		file_rpc_pulse_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4601,8 +8220,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupAssignRequest); i {
+		file_rpc_pulse_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4613,8 +8232,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupAssignResponse); i {
+		file_rpc_pulse_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4625,8 +8244,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupUnassignRequest); i {
+		file_rpc_pulse_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4637,8 +8256,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupUnassignResponse); i {
+		file_rpc_pulse_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4649,8 +8268,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupTableRequest); i {
+		file_rpc_pulse_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4661,8 +8280,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupTableResponse); i {
+		file_rpc_pulse_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4673,8 +8292,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupRow); i {
+		file_rpc_pulse_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4685,8 +8304,140 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusRequest); i {
+		file_rpc_pulse_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigVersionRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigBackupRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRollbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRollbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertStatusRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pulse_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4697,8 +8448,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+		file_rpc_pulse_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertRotateCARequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4709,8 +8460,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusRow); i {
+		file_rpc_pulse_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertRotateCAResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4721,8 +8472,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigRequest); i {
+		file_rpc_pulse_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertRevokeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4733,8 +8484,8 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigResponse); i {
+		file_rpc_pulse_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertRevokeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4745,7 +8496,7 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pulse_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TokenRequest); i {
 			case 0:
 				return &v.state
@@ -4757,7 +8508,7 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pulse_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TokenResponse); i {
 			case 0:
 				return &v.state
@@ -4769,7 +8520,7 @@ func file_rpc_pulse_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pulse_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pulse_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PulseNetwork); i {
 			case 0:
 				return &v.state
@@ -4788,7 +8539,7 @@ func file_rpc_pulse_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_pulse_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   53,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -4839,6 +8590,8 @@ type CLIClient interface {
 	GroupUnassign(ctx context.Context, in *GroupUnassignRequest, opts ...grpc.CallOption) (*GroupUnassignResponse, error)
 	// Get group list
 	GroupList(ctx context.Context, in *GroupTableRequest, opts ...grpc.CallOption) (*GroupTableResponse, error)
+	// Place a group on a node
+	GroupPlace(ctx context.Context, in *GroupPlaceRequest, opts ...grpc.CallOption) (*GroupPlaceResponse, error)
 	// Pulse Status
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	// Promote a member
@@ -4851,6 +8604,24 @@ type CLIClient interface {
 	Network(ctx context.Context, in *PulseNetwork, opts ...grpc.CallOption) (*PulseNetwork, error)
 	// Get detailed information for a particular node
 	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	// List running background tasks
+	Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	// Update the settings of a node
+	Node(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*NodeResponse, error)
+	// Move a node in or out of maintenance
+	Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error)
+	// List the config version of every node
+	ConfigVersions(ctx context.Context, in *ConfigVersionsRequest, opts ...grpc.CallOption) (*ConfigVersionsResponse, error)
+	// List the local config backups
+	ConfigHistory(ctx context.Context, in *ConfigHistoryRequest, opts ...grpc.CallOption) (*ConfigHistoryResponse, error)
+	// Restore a local config backup
+	ConfigRollback(ctx context.Context, in *ConfigRollbackRequest, opts ...grpc.CallOption) (*ConfigRollbackResponse, error)
+	// List the certificate expiry of every node and the CA
+	CertStatus(ctx context.Context, in *CertStatusRequest, opts ...grpc.CallOption) (*CertStatusResponse, error)
+	// Rotate the cluster CA
+	CertRotateCA(ctx context.Context, in *CertRotateCARequest, opts ...grpc.CallOption) (*CertRotateCAResponse, error)
+	// Revoke a certificate
+	CertRevoke(ctx context.Context, in *CertRevokeRequest, opts ...grpc.CallOption) (*CertRevokeResponse, error)
 }
 
 type cLIClient struct {
@@ -4969,6 +8740,15 @@ func (c *cLIClient) GroupList(ctx context.Context, in *GroupTableRequest, opts .
 	return out, nil
 }
 
+func (c *cLIClient) GroupPlace(ctx context.Context, in *GroupPlaceRequest, opts ...grpc.CallOption) (*GroupPlaceResponse, error) {
+	out := new(GroupPlaceResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/GroupPlace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cLIClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	out := new(StatusResponse)
 	err := c.cc.Invoke(ctx, "/proto.CLI/Status", in, out, opts...)
@@ -5023,6 +8803,87 @@ func (c *cLIClient) Describe(ctx context.Context, in *DescribeRequest, opts ...g
 	return out, nil
 }
 
+func (c *cLIClient) Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/Tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) Node(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*NodeResponse, error) {
+	out := new(NodeResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/Node", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error) {
+	out := new(MaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/Maintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) ConfigVersions(ctx context.Context, in *ConfigVersionsRequest, opts ...grpc.CallOption) (*ConfigVersionsResponse, error) {
+	out := new(ConfigVersionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/ConfigVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) ConfigHistory(ctx context.Context, in *ConfigHistoryRequest, opts ...grpc.CallOption) (*ConfigHistoryResponse, error) {
+	out := new(ConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/ConfigHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) ConfigRollback(ctx context.Context, in *ConfigRollbackRequest, opts ...grpc.CallOption) (*ConfigRollbackResponse, error) {
+	out := new(ConfigRollbackResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/ConfigRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) CertStatus(ctx context.Context, in *CertStatusRequest, opts ...grpc.CallOption) (*CertStatusResponse, error) {
+	out := new(CertStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/CertStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) CertRotateCA(ctx context.Context, in *CertRotateCARequest, opts ...grpc.CallOption) (*CertRotateCAResponse, error) {
+	out := new(CertRotateCAResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/CertRotateCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cLIClient) CertRevoke(ctx context.Context, in *CertRevokeRequest, opts ...grpc.CallOption) (*CertRevokeResponse, error) {
+	out := new(CertRevokeResponse)
+	err := c.cc.Invoke(ctx, "/proto.CLI/CertRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CLIServer is the server API for CLI service.
 type CLIServer interface {
 	// Join Cluster
@@ -5049,6 +8910,8 @@ type CLIServer interface {
 	GroupUnassign(context.Context, *GroupUnassignRequest) (*GroupUnassignResponse, error)
 	// Get group list
 	GroupList(context.Context, *GroupTableRequest) (*GroupTableResponse, error)
+	// Place a group on a node
+	GroupPlace(context.Context, *GroupPlaceRequest) (*GroupPlaceResponse, error)
 	// Pulse Status
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
 	// Promote a member
@@ -5061,6 +8924,24 @@ type CLIServer interface {
 	Network(context.Context, *PulseNetwork) (*PulseNetwork, error)
 	// Get detailed information for a particular node
 	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	// List running background tasks
+	Tasks(context.Context, *TasksRequest) (*TasksResponse, error)
+	// Update the settings of a node
+	Node(context.Context, *NodeRequest) (*NodeResponse, error)
+	// Move a node in or out of maintenance
+	Maintenance(context.Context, *MaintenanceRequest) (*MaintenanceResponse, error)
+	// List the config version of every node
+	ConfigVersions(context.Context, *ConfigVersionsRequest) (*ConfigVersionsResponse, error)
+	// List the local config backups
+	ConfigHistory(context.Context, *ConfigHistoryRequest) (*ConfigHistoryResponse, error)
+	// Restore a local config backup
+	ConfigRollback(context.Context, *ConfigRollbackRequest) (*ConfigRollbackResponse, error)
+	// List the certificate expiry of every node and the CA
+	CertStatus(context.Context, *CertStatusRequest) (*CertStatusResponse, error)
+	// Rotate the cluster CA
+	CertRotateCA(context.Context, *CertRotateCARequest) (*CertRotateCAResponse, error)
+	// Revoke a certificate
+	CertRevoke(context.Context, *CertRevokeRequest) (*CertRevokeResponse, error)
 }
 
 // UnimplementedCLIServer can be embedded to have forward compatible implementations.
@@ -5103,6 +8984,9 @@ func (*UnimplementedCLIServer) GroupUnassign(context.Context, *GroupUnassignRequ
 func (*UnimplementedCLIServer) GroupList(context.Context, *GroupTableRequest) (*GroupTableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupList not implemented")
 }
+func (*UnimplementedCLIServer) GroupPlace(context.Context, *GroupPlaceRequest) (*GroupPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupPlace not implemented")
+}
 func (*UnimplementedCLIServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
@@ -5121,6 +9005,33 @@ func (*UnimplementedCLIServer) Network(context.Context, *PulseNetwork) (*PulseNe
 func (*UnimplementedCLIServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
 }
+func (*UnimplementedCLIServer) Tasks(context.Context, *TasksRequest) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tasks not implemented")
+}
+func (*UnimplementedCLIServer) Node(context.Context, *NodeRequest) (*NodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Node not implemented")
+}
+func (*UnimplementedCLIServer) Maintenance(context.Context, *MaintenanceRequest) (*MaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Maintenance not implemented")
+}
+func (*UnimplementedCLIServer) ConfigVersions(context.Context, *ConfigVersionsRequest) (*ConfigVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigVersions not implemented")
+}
+func (*UnimplementedCLIServer) ConfigHistory(context.Context, *ConfigHistoryRequest) (*ConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigHistory not implemented")
+}
+func (*UnimplementedCLIServer) ConfigRollback(context.Context, *ConfigRollbackRequest) (*ConfigRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigRollback not implemented")
+}
+func (*UnimplementedCLIServer) CertStatus(context.Context, *CertStatusRequest) (*CertStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertStatus not implemented")
+}
+func (*UnimplementedCLIServer) CertRotateCA(context.Context, *CertRotateCARequest) (*CertRotateCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertRotateCA not implemented")
+}
+func (*UnimplementedCLIServer) CertRevoke(context.Context, *CertRevokeRequest) (*CertRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertRevoke not implemented")
+}
 
 func RegisterCLIServer(s *grpc.Server, srv CLIServer) {
 	s.RegisterService(&_CLI_serviceDesc, srv)
@@ -5262,190 +9173,370 @@ func _CLI_GroupIPAdd_Handler(srv interface{}, ctx context.Context, dec func(inte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/GroupIPAdd",
+		FullMethod: "/proto.CLI/GroupIPAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).GroupIPAdd(ctx, req.(*GroupAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_GroupIPRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).GroupIPRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/GroupIPRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).GroupIPRemove(ctx, req.(*GroupRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_GroupAssign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupAssignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).GroupAssign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/GroupAssign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).GroupAssign(ctx, req.(*GroupAssignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_GroupUnassign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupUnassignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).GroupUnassign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/GroupUnassign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).GroupUnassign(ctx, req.(*GroupUnassignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_GroupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).GroupList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/GroupList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).GroupList(ctx, req.(*GroupTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_GroupPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).GroupPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/GroupPlace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).GroupPlace(ctx, req.(*GroupPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).Promote(ctx, req.(*PromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).Config(ctx, req.(*ConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).Token(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/Token",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLIServer).Token(ctx, req.(*TokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CLI_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PulseNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLIServer).Network(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CLI/Network",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).GroupIPAdd(ctx, req.(*GroupAddRequest))
+		return srv.(CLIServer).Network(ctx, req.(*PulseNetwork))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_GroupIPRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupRemoveRequest)
+func _CLI_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).GroupIPRemove(ctx, in)
+		return srv.(CLIServer).Describe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/GroupIPRemove",
+		FullMethod: "/proto.CLI/Describe",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).GroupIPRemove(ctx, req.(*GroupRemoveRequest))
+		return srv.(CLIServer).Describe(ctx, req.(*DescribeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_GroupAssign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupAssignRequest)
+func _CLI_Tasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).GroupAssign(ctx, in)
+		return srv.(CLIServer).Tasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/GroupAssign",
+		FullMethod: "/proto.CLI/Tasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).GroupAssign(ctx, req.(*GroupAssignRequest))
+		return srv.(CLIServer).Tasks(ctx, req.(*TasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_GroupUnassign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupUnassignRequest)
+func _CLI_Node_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).GroupUnassign(ctx, in)
+		return srv.(CLIServer).Node(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/GroupUnassign",
+		FullMethod: "/proto.CLI/Node",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).GroupUnassign(ctx, req.(*GroupUnassignRequest))
+		return srv.(CLIServer).Node(ctx, req.(*NodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_GroupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupTableRequest)
+func _CLI_Maintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).GroupList(ctx, in)
+		return srv.(CLIServer).Maintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/GroupList",
+		FullMethod: "/proto.CLI/Maintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).GroupList(ctx, req.(*GroupTableRequest))
+		return srv.(CLIServer).Maintenance(ctx, req.(*MaintenanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatusRequest)
+func _CLI_ConfigVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).Status(ctx, in)
+		return srv.(CLIServer).ConfigVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/Status",
+		FullMethod: "/proto.CLI/ConfigVersions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).Status(ctx, req.(*StatusRequest))
+		return srv.(CLIServer).ConfigVersions(ctx, req.(*ConfigVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PromoteRequest)
+func _CLI_ConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).Promote(ctx, in)
+		return srv.(CLIServer).ConfigHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/Promote",
+		FullMethod: "/proto.CLI/ConfigHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).Promote(ctx, req.(*PromoteRequest))
+		return srv.(CLIServer).ConfigHistory(ctx, req.(*ConfigHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigRequest)
+func _CLI_ConfigRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRollbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).Config(ctx, in)
+		return srv.(CLIServer).ConfigRollback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/Config",
+		FullMethod: "/proto.CLI/ConfigRollback",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).Config(ctx, req.(*ConfigRequest))
+		return srv.(CLIServer).ConfigRollback(ctx, req.(*ConfigRollbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TokenRequest)
+func _CLI_CertStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).Token(ctx, in)
+		return srv.(CLIServer).CertStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/Token",
+		FullMethod: "/proto.CLI/CertStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).Token(ctx, req.(*TokenRequest))
+		return srv.(CLIServer).CertStatus(ctx, req.(*CertStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PulseNetwork)
+func _CLI_CertRotateCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertRotateCARequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).Network(ctx, in)
+		return srv.(CLIServer).CertRotateCA(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/Network",
+		FullMethod: "/proto.CLI/CertRotateCA",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).Network(ctx, req.(*PulseNetwork))
+		return srv.(CLIServer).CertRotateCA(ctx, req.(*CertRotateCARequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CLI_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeRequest)
+func _CLI_CertRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertRevokeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CLIServer).Describe(ctx, in)
+		return srv.(CLIServer).CertRevoke(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.CLI/Describe",
+		FullMethod: "/proto.CLI/CertRevoke",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CLIServer).Describe(ctx, req.(*DescribeRequest))
+		return srv.(CLIServer).CertRevoke(ctx, req.(*CertRevokeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5502,6 +9593,10 @@ var _CLI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GroupList",
 			Handler:    _CLI_GroupList_Handler,
 		},
+		{
+			MethodName: "GroupPlace",
+			Handler:    _CLI_GroupPlace_Handler,
+		},
 		{
 			MethodName: "Status",
 			Handler:    _CLI_Status_Handler,
@@ -5526,6 +9621,42 @@ var _CLI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Describe",
 			Handler:    _CLI_Describe_Handler,
 		},
+		{
+			MethodName: "Tasks",
+			Handler:    _CLI_Tasks_Handler,
+		},
+		{
+			MethodName: "Node",
+			Handler:    _CLI_Node_Handler,
+		},
+		{
+			MethodName: "Maintenance",
+			Handler:    _CLI_Maintenance_Handler,
+		},
+		{
+			MethodName: "ConfigVersions",
+			Handler:    _CLI_ConfigVersions_Handler,
+		},
+		{
+			MethodName: "ConfigHistory",
+			Handler:    _CLI_ConfigHistory_Handler,
+		},
+		{
+			MethodName: "ConfigRollback",
+			Handler:    _CLI_ConfigRollback_Handler,
+		},
+		{
+			MethodName: "CertStatus",
+			Handler:    _CLI_CertStatus_Handler,
+		},
+		{
+			MethodName: "CertRotateCA",
+			Handler:    _CLI_CertRotateCA_Handler,
+		},
+		{
+			MethodName: "CertRevoke",
+			Handler:    _CLI_CertRevoke_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/pulse.proto",
@@ -5557,6 +9688,22 @@ type ServerClient interface {
 	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (*LogsResponse, error)
 	// Get detailed information for a particular node
 	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	// Fail over vote
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	// Check that a member is ready to become active
+	Preflight(ctx context.Context, in *PreflightRequest, opts ...grpc.CallOption) (*PreflightResponse, error)
+	// List the floating IP groups currently up on a member
+	GroupStatus(ctx context.Context, in *GroupStatusRequest, opts ...grpc.CallOption) (*GroupStatusResponse, error)
+	// Mesh heartbeat exchanged between every member
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	// Get the config version and optionally the config of a member
+	ConfigVersion(ctx context.Context, in *ConfigVersionRequest, opts ...grpc.CallOption) (*ConfigVersionResponse, error)
+	// Sign a member certificate with the cluster CA
+	SignCertificate(ctx context.Context, in *SignCertificateRequest, opts ...grpc.CallOption) (*SignCertificateResponse, error)
+	// Get the certificates of a member
+	CertificateStatus(ctx context.Context, in *CertificateStatusRequest, opts ...grpc.CallOption) (*CertificateStatusResponse, error)
+	// Update the CA, revocation list or certificate of a member
+	CertUpdate(ctx context.Context, in *CertUpdateRequest, opts ...grpc.CallOption) (*CertUpdateResponse, error)
 }
 
 type serverClient struct {
@@ -5666,6 +9813,78 @@ func (c *serverClient) Describe(ctx context.Context, in *DescribeRequest, opts .
 	return out, nil
 }
 
+func (c *serverClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) Preflight(ctx context.Context, in *PreflightRequest, opts ...grpc.CallOption) (*PreflightResponse, error) {
+	out := new(PreflightResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/Preflight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) GroupStatus(ctx context.Context, in *GroupStatusRequest, opts ...grpc.CallOption) (*GroupStatusResponse, error) {
+	out := new(GroupStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/GroupStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) ConfigVersion(ctx context.Context, in *ConfigVersionRequest, opts ...grpc.CallOption) (*ConfigVersionResponse, error) {
+	out := new(ConfigVersionResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/ConfigVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) SignCertificate(ctx context.Context, in *SignCertificateRequest, opts ...grpc.CallOption) (*SignCertificateResponse, error) {
+	out := new(SignCertificateResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/SignCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) CertificateStatus(ctx context.Context, in *CertificateStatusRequest, opts ...grpc.CallOption) (*CertificateStatusResponse, error) {
+	out := new(CertificateStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/CertificateStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) CertUpdate(ctx context.Context, in *CertUpdateRequest, opts ...grpc.CallOption) (*CertUpdateResponse, error) {
+	out := new(CertUpdateResponse)
+	err := c.cc.Invoke(ctx, "/proto.Server/CertUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServerServer is the server API for Server service.
 type ServerServer interface {
 	// Perform GRPC Health Check
@@ -5690,6 +9909,22 @@ type ServerServer interface {
 	Logs(context.Context, *LogsRequest) (*LogsResponse, error)
 	// Get detailed information for a particular node
 	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	// Fail over vote
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
+	// Check that a member is ready to become active
+	Preflight(context.Context, *PreflightRequest) (*PreflightResponse, error)
+	// List the floating IP groups currently up on a member
+	GroupStatus(context.Context, *GroupStatusRequest) (*GroupStatusResponse, error)
+	// Mesh heartbeat exchanged between every member
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	// Get the config version and optionally the config of a member
+	ConfigVersion(context.Context, *ConfigVersionRequest) (*ConfigVersionResponse, error)
+	// Sign a member certificate with the cluster CA
+	SignCertificate(context.Context, *SignCertificateRequest) (*SignCertificateResponse, error)
+	// Get the certificates of a member
+	CertificateStatus(context.Context, *CertificateStatusRequest) (*CertificateStatusResponse, error)
+	// Update the CA, revocation list or certificate of a member
+	CertUpdate(context.Context, *CertUpdateRequest) (*CertUpdateResponse, error)
 }
 
 // UnimplementedServerServer can be embedded to have forward compatible implementations.
@@ -5729,6 +9964,30 @@ func (*UnimplementedServerServer) Logs(context.Context, *LogsRequest) (*LogsResp
 func (*UnimplementedServerServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
 }
+func (*UnimplementedServerServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedServerServer) Preflight(context.Context, *PreflightRequest) (*PreflightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Preflight not implemented")
+}
+func (*UnimplementedServerServer) GroupStatus(context.Context, *GroupStatusRequest) (*GroupStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupStatus not implemented")
+}
+func (*UnimplementedServerServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedServerServer) ConfigVersion(context.Context, *ConfigVersionRequest) (*ConfigVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigVersion not implemented")
+}
+func (*UnimplementedServerServer) SignCertificate(context.Context, *SignCertificateRequest) (*SignCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCertificate not implemented")
+}
+func (*UnimplementedServerServer) CertificateStatus(context.Context, *CertificateStatusRequest) (*CertificateStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertificateStatus not implemented")
+}
+func (*UnimplementedServerServer) CertUpdate(context.Context, *CertUpdateRequest) (*CertUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertUpdate not implemented")
+}
 
 func RegisterServerServer(s *grpc.Server, srv ServerServer) {
 	s.RegisterService(&_Server_serviceDesc, srv)
@@ -5932,6 +10191,150 @@ func _Server_Describe_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Server_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_Preflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).Preflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/Preflight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).Preflight(ctx, req.(*PreflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_GroupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).GroupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/GroupStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).GroupStatus(ctx, req.(*GroupStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_ConfigVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).ConfigVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/ConfigVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).ConfigVersion(ctx, req.(*ConfigVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_SignCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).SignCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/SignCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).SignCertificate(ctx, req.(*SignCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_CertificateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).CertificateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/CertificateStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).CertificateStatus(ctx, req.(*CertificateStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_CertUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).CertUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Server/CertUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).CertUpdate(ctx, req.(*CertUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Server_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Server",
 	HandlerType: (*ServerServer)(nil),
@@ -5980,6 +10383,38 @@ var _Server_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Describe",
 			Handler:    _Server_Describe_Handler,
 		},
+		{
+			MethodName: "Vote",
+			Handler:    _Server_Vote_Handler,
+		},
+		{
+			MethodName: "Preflight",
+			Handler:    _Server_Preflight_Handler,
+		},
+		{
+			MethodName: "GroupStatus",
+			Handler:    _Server_GroupStatus_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Server_Heartbeat_Handler,
+		},
+		{
+			MethodName: "ConfigVersion",
+			Handler:    _Server_ConfigVersion_Handler,
+		},
+		{
+			MethodName: "SignCertificate",
+			Handler:    _Server_SignCertificate_Handler,
+		},
+		{
+			MethodName: "CertificateStatus",
+			Handler:    _Server_CertificateStatus_Handler,
+		},
+		{
+			MethodName: "CertUpdate",
+			Handler:    _Server_CertUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/pulse.proto",
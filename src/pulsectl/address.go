@@ -0,0 +1,40 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulsectl
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"os"
+)
+
+// cliAddress returns the address of the PulseHA CLI server.
+// Note: Set PULSEHA_CLI_ADDRESS when the daemon listens on another address.
+func cliAddress() string {
+	if address := os.Getenv("PULSEHA_CLI_ADDRESS"); address != "" {
+		return address
+	}
+	return config.DefaultCLIAddress
+}
+
+// pluginDir returns the folder PulseHA loads plugins from.
+// Note: Set PULSEHA_PLUGIN_DIR when the daemon loads plugins from another folder.
+func pluginDir() string {
+	if dir := os.Getenv("PULSEHA_PLUGIN_DIR"); dir != "" {
+		return dir
+	}
+	return config.DefaultPluginDir
+}
@@ -20,10 +20,15 @@ import (
 	"context"
 	"flag"
 	"github.com/mitchellh/cli"
+	"github.com/olekukonko/tablewriter"
+	"github.com/syleron/pulseha/packages/security"
 	"github.com/syleron/pulseha/packages/utils"
 	"github.com/syleron/pulseha/rpc"
 	"google.golang.org/grpc"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type CertCommand struct {
@@ -37,6 +42,12 @@ func (c *CertCommand) Help() string {
 	helpText := `
 Usage: pulsectl cert <Bind IP>
   Generate new TLS certificates for PulseHA.
+Actions:
+  - status - Show the certificate expiry of every node and the CA.
+  - rotate-ca - Replace the cluster CA and renew every certificate.
+  - revoke <serial> - Revoke a certificate.
+Options:
+  -finish  Finish a CA rotation that couldn't renew every certificate
 `
 	return strings.TrimSpace(helpText)
 }
@@ -47,6 +58,7 @@ Run the CLI command
 func (c *CertCommand) Run(args []string) int {
 	cmdFlags := flag.NewFlagSet("cert", flag.ContinueOnError)
 	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	finish := cmdFlags.Bool("finish", false, "Finish a CA rotation")
 
 	// Make sure we have cmd args
 	if err := cmdFlags.Parse(args); err != nil {
@@ -55,6 +67,24 @@ func (c *CertCommand) Run(args []string) int {
 
 	cmds := cmdFlags.Args()
 
+	if len(cmds) == 1 && cmds[0] == "status" {
+		return c.Status()
+	}
+
+	if len(cmds) == 1 && cmds[0] == "rotate-ca" {
+		return c.RotateCA(*finish)
+	}
+
+	if len(cmds) > 0 && cmds[0] == "revoke" {
+		if len(cmds) != 2 {
+			c.Ui.Error("Please specify the serial number of the certificate to revoke")
+			c.Ui.Error("")
+			c.Ui.Error(c.Help())
+			return 1
+		}
+		return c.Revoke(cmds[1])
+	}
+
 	// If no action is provided then just list our current config
 	if len(cmds) < 1 {
 		c.Ui.Error("Please specify the PulseHA bind IP address\n")
@@ -74,7 +104,7 @@ func (c *CertCommand) Run(args []string) int {
 		return 1
 	}
 
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 
 	if err != nil {
 		c.Ui.Error("GRPC client connection error")
@@ -105,6 +135,136 @@ func (c *CertCommand) Run(args []string) int {
 	return 0
 }
 
+/**
+ * Shows the certificate expiry of every node and the CA.
+ */
+func (c *CertCommand) Status() int {
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+	r, err := client.CertStatus(context.Background(), &rpc.CertStatusRequest{})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	data := [][]string{}
+	for _, row := range r.Row {
+		if row.Error != "" {
+			data = append(data, []string{row.Hostname, "", "", "", "", "", row.Error})
+			continue
+		}
+		hostname, caKey, crl := row.Hostname, "no", strconv.FormatUint(row.CrlNumber, 10)
+		if hostname == "" {
+			hostname, caKey, crl = "CA", "", ""
+		} else if row.CaKey {
+			caKey = "yes"
+		}
+		data = append(data, []string{
+			hostname,
+			row.Cert.Subject,
+			row.Cert.Serial,
+			row.Cert.NotAfter,
+			caKey,
+			crl,
+			certExpiry(row.Cert.NotAfter),
+		})
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Node Hostname", "Subject", "Serial", "Expires", "CA Key", "CRL", "Status"})
+	table.SetCenterSeparator("-")
+	table.SetColumnSeparator("|")
+	table.SetRowLine(true)
+	table.SetAutoMergeCells(false)
+	table.AppendBulk(data)
+	table.Render()
+	return 0
+}
+
+/**
+ * Describes how long a certificate has left.
+ */
+func certExpiry(notAfter string) string {
+	expires, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return "unknown"
+	}
+	left := time.Until(expires)
+	switch {
+	case left <= 0:
+		return "expired"
+	case left <= security.RenewBefore:
+		return "renewal due (" + strconv.Itoa(int(left.Hours()/24)) + " days left)"
+	default:
+		return strconv.Itoa(int(left.Hours()/24)) + " days left"
+	}
+}
+
+/**
+ * Rotates the cluster CA.
+ */
+func (c *CertCommand) RotateCA(finish bool) int {
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+	r, err := client.CertRotateCA(context.Background(), &rpc.CertRotateCARequest{
+		Finish: finish,
+	})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	c.Ui.Output("\n[\u2713] " + r.Message + "\n")
+	return 0
+}
+
+/**
+ * Revokes a certificate.
+ */
+func (c *CertCommand) Revoke(serial string) int {
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+	r, err := client.CertRevoke(context.Background(), &rpc.CertRevokeRequest{
+		Serial: serial,
+	})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	c.Ui.Output("\n[\u2713] " + r.Message + "\n")
+	return 0
+}
+
 /**
  *
  */
@@ -20,8 +20,12 @@ import (
 	"context"
 	"flag"
 	"github.com/mitchellh/cli"
+	"github.com/olekukonko/tablewriter"
+	"github.com/syleron/pulseha/packages/config"
 	"github.com/syleron/pulseha/rpc"
 	"google.golang.org/grpc"
+	"os"
+	"strconv"
 	"strings"
 )
 
@@ -37,7 +41,16 @@ func (c *ConfigCommand) Help() string {
 Usage: pulsectl config <key> <new-value> [options] ...
   Update pulse config key with a new value defined in the 
   pulsectl section in the config
+Actions:
+  - version - Show the cluster config version of every node.
+  - history - List the local config backups.
+  - rollback <n> - Restore local config backup n (1 is the newest).
+    Only the pulseha and plugins sections are restored on a node in a cluster.
+  - validate <file> - Check a JSON or YAML config file without applying it.
 Options:
+  -replicate  Replicate a rollback to every node in the cluster
+  -plugins    Load the plugins in the plugin folder to validate sections
+              that have no schema file. This runs the plugins' code.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -48,11 +61,46 @@ Options:
 func (c *ConfigCommand) Run(args []string) int {
 	cmdFlags := flag.NewFlagSet("config", flag.ContinueOnError)
 	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	replicate := cmdFlags.Bool("replicate", false, "Replicate a rollback to every node")
+	plugins := cmdFlags.Bool("plugins", false, "Load the plugins to validate their sections")
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
 	cmds := cmdFlags.Args()
 
+	if len(cmds) == 1 && cmds[0] == "version" {
+		return c.Version()
+	}
+
+	if len(cmds) > 0 && cmds[0] == "validate" {
+		if len(cmds) != 2 {
+			c.Ui.Error("Please specify the config file to validate")
+			c.Ui.Error("")
+			c.Ui.Error(c.Help())
+			return 1
+		}
+		return c.Validate(cmds[1], *plugins)
+	}
+
+	if len(cmds) == 1 && cmds[0] == "history" {
+		return c.History()
+	}
+
+	if len(cmds) > 0 && cmds[0] == "rollback" {
+		if len(cmds) != 2 {
+			c.Ui.Error("Please specify the config backup to restore")
+			c.Ui.Error("")
+			c.Ui.Error(c.Help())
+			return 1
+		}
+		index, err := strconv.Atoi(cmds[1])
+		if err != nil || index < 1 {
+			c.Ui.Error("Please specify a valid config backup number")
+			return 1
+		}
+		return c.Rollback(index, *replicate)
+	}
+
 	if len(cmds) == 0 {
 		c.Ui.Error("Please specify a config key to update")
 		c.Ui.Error("")
@@ -67,7 +115,7 @@ func (c *ConfigCommand) Run(args []string) int {
 		return 1
 	}
 
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 
 	if err != nil {
 		c.Ui.Error("GRPC client connection error")
@@ -97,6 +145,178 @@ func (c *ConfigCommand) Run(args []string) int {
 	return 0
 }
 
+/**
+ * Shows the cluster config version of every node.
+ */
+func (c *ConfigCommand) Version() int {
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+	r, err := client.ConfigVersions(context.Background(), &rpc.ConfigVersionsRequest{})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	// Find the newest version in the cluster
+	var newest config.Version
+	for _, row := range r.Row {
+		if version := configVersion(row.Version); row.Error == "" && version.Supersedes(newest) {
+			newest = version
+		}
+	}
+	data := [][]string{}
+	for _, row := range r.Row {
+		version := configVersion(row.Version)
+		status := "current"
+		switch {
+		case row.Error != "":
+			status = row.Error
+		case newest.Supersedes(version):
+			status = "behind"
+		}
+		number := ""
+		if row.Error == "" {
+			number = strconv.FormatUint(version.Number, 10)
+		}
+		data = append(data, []string{row.Hostname, number, version.Origin, status})
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Node Hostname", "Version", "Changed By", "Status"})
+	table.SetCenterSeparator("-")
+	table.SetColumnSeparator("|")
+	table.SetRowLine(true)
+	table.SetAutoMergeCells(false)
+	table.AppendBulk(data)
+	table.Render()
+	return 0
+}
+
+/**
+ * Checks a config file offline.
+ * Note: Plugin sections are checked against the schema files in the plugin folder.
+ * The plugins themselves are only loaded when asked to as loading them runs their code.
+ */
+func (c *ConfigCommand) Validate(file string, plugins bool) int {
+	for _, err := range config.LoadPluginSchemaFiles(pluginDir()) {
+		c.Ui.Warn("[!] " + err.Error() + ". Its config section won't be checked.")
+	}
+	// A loaded plugin's own schema replaces the one from its schema file
+	if plugins {
+		for _, err := range config.LoadPluginSchemas(pluginDir()) {
+			c.Ui.Warn("[!] " + err.Error() + ". Its config section won't be checked.")
+		}
+	}
+	conf, err := config.ReadFile(file)
+	if err == nil {
+		err = conf.ValidateSettings()
+	}
+	if err != nil {
+		c.Ui.Output("\n[x] " + file + " is not a valid PulseHA config: " + err.Error() + "\n")
+		return 1
+	}
+	for _, warning := range conf.Warnings() {
+		c.Ui.Warn("[!] " + warning + ". The value is ignored.")
+	}
+	c.Ui.Output("\n[\u2713] " + file + " is a valid PulseHA config\n")
+	return 0
+}
+
+/**
+ * Lists the local config backups.
+ */
+func (c *ConfigCommand) History() int {
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+	r, err := client.ConfigHistory(context.Background(), &rpc.ConfigHistoryRequest{})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	if len(r.Row) == 0 {
+		c.Ui.Output("\nNo config backups found\n")
+		return 0
+	}
+	data := [][]string{}
+	for _, row := range r.Row {
+		version := configVersion(row.Version)
+		data = append(data, []string{
+			strconv.Itoa(int(row.Index)),
+			row.Time,
+			strconv.FormatUint(version.Number, 10),
+			version.Origin,
+		})
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Backup", "Taken", "Version", "Changed By"})
+	table.SetCenterSeparator("-")
+	table.SetColumnSeparator("|")
+	table.SetRowLine(true)
+	table.SetAutoMergeCells(false)
+	table.AppendBulk(data)
+	table.Render()
+	return 0
+}
+
+/**
+ * Restores a local config backup.
+ */
+func (c *ConfigCommand) Rollback(index int, replicate bool) int {
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+	r, err := client.ConfigRollback(context.Background(), &rpc.ConfigRollbackRequest{
+		Index:     int32(index),
+		Replicate: replicate,
+	})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	c.Ui.Output("\n[\u2713] " + r.Message + "\n")
+	return 0
+}
+
+/**
+ * Converts a config version from its RPC form.
+ */
+func configVersion(v *rpc.ConfigVersion) config.Version {
+	if v == nil {
+		return config.Version{}
+	}
+	return config.Version{Number: v.Number, Origin: v.Origin}
+}
+
 // Synopsis	- Description of the command
 func (c *ConfigCommand) Synopsis() string {
 	return "Manage floating IP groups"
@@ -82,7 +82,7 @@ func (c *CreateCommand) Run(args []string) int {
 		return 1
 	}
 
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 
 	if err != nil {
 		c.Ui.Error("GRPC client connection error")
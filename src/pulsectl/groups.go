@@ -36,7 +36,7 @@ type GroupsCommand struct {
  */
 func (c *GroupsCommand) Help() string {
 	helpText := `
-Usage: pulsectl group [options] (new/delete/add/remove/assign/unassign) ...
+Usage: pulsectl group [options] (new/delete/add/remove/assign/unassign/place/unplace) ...
   Tells a running PulseHA agent to join the cluster
   by specifying at least one existing member.
 Options:
@@ -66,7 +66,7 @@ func (c *GroupsCommand) Run(args []string) int {
 
 	cmds := cmdFlags.Args()
 
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 
 	if err != nil {
 		c.Ui.Error("GRPC client connection error")
@@ -97,6 +97,11 @@ func (c *GroupsCommand) Run(args []string) int {
 		return c.Assign(groupName, nodeHostname, nodeIface, client)
 	case "unassign":
 		return c.Unassign(groupName, nodeHostname, nodeIface, client)
+	case "place":
+		return c.Place(groupName, nodeHostname, client)
+	case "unplace":
+		empty := ""
+		return c.Place(groupName, &empty, client)
 	default:
 		c.Ui.Error("Unknown action provided.")
 		c.Ui.Error("")
@@ -123,7 +128,10 @@ func (c *GroupsCommand) drawGroupsTable(client rpc.CLIClient) {
 	} else {
 		data := [][]string{}
 		for _, group := range r.Row {
-
+			placement := group.Placement
+			if placement == "" {
+				placement = "(follows active)"
+			}
 			data = append(
 				data,
 				[]string{
@@ -131,6 +139,8 @@ func (c *GroupsCommand) drawGroupsTable(client rpc.CLIClient) {
 					strings.Join(group.Ip, ", "),
 					strings.Join(group.Nodes, "\n"),
 					strings.Join(group.Interfaces, "\n"),
+					group.Owner,
+					placement,
 				})
 		}
 		table := tablewriter.NewWriter(os.Stdout)
@@ -139,6 +149,8 @@ func (c *GroupsCommand) drawGroupsTable(client rpc.CLIClient) {
 			"IP Assignments",
 			"Nodes",
 			"Ifaces",
+			"Owner",
+			"Placement",
 		})
 		table.SetCenterSeparator("-")
 		table.SetColumnSeparator("|")
@@ -348,3 +360,31 @@ func (c *GroupsCommand) Unassign(groupName, nodeHostname, nodeIface *string, cli
 	}
 	return 0
 }
+
+/**
+ *
+ */
+func (c *GroupsCommand) Place(groupName, nodeHostname *string, client rpc.CLIClient) int {
+	if *groupName == "" {
+		c.Ui.Error("Please specify a group name")
+		c.Ui.Error("")
+		c.Ui.Error(c.Help())
+		return 1
+	}
+	r, err := client.GroupPlace(context.Background(), &rpc.GroupPlaceRequest{
+		Group: *groupName,
+		Node:  *nodeHostname,
+	})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+	} else {
+		if r.Success {
+			c.Ui.Output("\n[\u2713] " + r.Message + "\n")
+		} else {
+			c.Ui.Output("\n[x] " + r.Message + "\n")
+			return 1
+		}
+	}
+	return 0
+}
@@ -132,7 +132,7 @@ func (c *JoinCommand) Run(args []string) int {
 	}
 
 	// setup a connection
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 
 	// handle the error
 	if err != nil {
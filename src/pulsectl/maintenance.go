@@ -0,0 +1,99 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulsectl
+
+import (
+	"context"
+	"flag"
+	"github.com/mitchellh/cli"
+	"github.com/syleron/pulseha/rpc"
+	"google.golang.org/grpc"
+	"strings"
+)
+
+type MaintenanceCommand struct {
+	Ui cli.Ui
+}
+
+/**
+ *
+ */
+func (c *MaintenanceCommand) Help() string {
+	helpText := `
+Usage: pulsectl maintenance on|off [node hostname]
+  Moves a node in or out of maintenance. Defaults to the local node.
+  An active node hands over to the next eligible member first.
+  Nodes in maintenance hold no floating IP groups and never become active.
+`
+	return strings.TrimSpace(helpText)
+}
+
+/**
+ *
+ */
+func (c *MaintenanceCommand) Run(args []string) int {
+	cmdFlags := flag.NewFlagSet("maintenance", flag.ContinueOnError)
+	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	cmds := cmdFlags.Args()
+
+	if len(cmds) == 0 || len(cmds) > 2 || (cmds[0] != "on" && cmds[0] != "off") {
+		c.Ui.Error("Please specify either on or off")
+		c.Ui.Error("")
+		c.Ui.Error(c.Help())
+		return 1
+	}
+
+	var hostname string
+	if len(cmds) == 2 {
+		hostname = cmds[1]
+	}
+
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+
+	r, err := client.Maintenance(context.Background(), &rpc.MaintenanceRequest{
+		Enabled:  cmds[0] == "on",
+		Hostname: hostname,
+	})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	c.Ui.Output("\n[\u2713] " + r.Message + "\n")
+	return 0
+}
+
+/**
+ *
+ */
+func (c *MaintenanceCommand) Synopsis() string {
+	return "Move a node in or out of maintenance"
+}
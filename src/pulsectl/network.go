@@ -54,7 +54,7 @@ func (c *NetworkCommand) Run(args []string) int {
 
 	cmds := cmdFlags.Args()
 
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 
 	if err != nil {
 		c.Ui.Error("GRPC client connection error. Is the PulseHA service running?")
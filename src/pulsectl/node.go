@@ -0,0 +1,107 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulsectl
+
+import (
+	"context"
+	"flag"
+	"github.com/mitchellh/cli"
+	"github.com/syleron/pulseha/rpc"
+	"google.golang.org/grpc"
+	"strings"
+)
+
+type NodeCommand struct {
+	Ui cli.Ui
+}
+
+/**
+ *
+ */
+func (c *NodeCommand) Help() string {
+	helpText := `
+Usage: pulsectl node <action> <hostname> <value>
+  Update the settings of a node in the cluster.
+Actions:
+  - monitoring <hostname> on|off - Monitoring nodes never become active.
+  - priority <hostname> <n> - Nodes with a higher priority become active first.
+  - preferred <hostname> on|off - The preferred node becomes active whenever
+    it is available and auto_failback is enabled.
+`
+	return strings.TrimSpace(helpText)
+}
+
+/**
+ *
+ */
+func (c *NodeCommand) Run(args []string) int {
+	cmdFlags := flag.NewFlagSet("node", flag.ContinueOnError)
+	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	cmds := cmdFlags.Args()
+
+	if len(cmds) != 3 {
+		c.Ui.Error("Please specify an action, node hostname and value")
+		c.Ui.Error("")
+		c.Ui.Error(c.Help())
+		return 1
+	}
+
+	switch cmds[0] {
+	case "monitoring", "priority", "preferred":
+	default:
+		c.Ui.Error("Unknown action provided.")
+		c.Ui.Error("")
+		c.Ui.Error(c.Help())
+		return 1
+	}
+
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+
+	r, err := client.Node(context.Background(), &rpc.NodeRequest{
+		Action:   cmds[0],
+		Hostname: cmds[1],
+		Value:    cmds[2],
+	})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	c.Ui.Output("\n[\u2713] " + r.Message + "\n")
+	return 0
+}
+
+/**
+ *
+ */
+func (c *NodeCommand) Synopsis() string {
+	return "Update the settings of a node in the cluster"
+}
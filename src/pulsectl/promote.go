@@ -34,8 +34,12 @@ type PromoteCommand struct {
  */
 func (c *PromoteCommand) Help() string {
 	helpText := `
-Usage: pulsectl status [options] ...
+Usage: pulsectl promote [options] <member hostname>
+  Switches the active role over to a member. Each step is reported and
+  a failed step rolls the switchover back.
 Options:
+  -force  Promote the member even if its health score is lower than the active
+  -ping   Ping the member's floating IPs once it is active and roll back if they don't respond
 `
 	return strings.TrimSpace(helpText)
 }
@@ -44,8 +48,10 @@ Options:
  *
  */
 func (c *PromoteCommand) Run(args []string) int {
-	cmdFlags := flag.NewFlagSet("status", flag.ContinueOnError)
+	cmdFlags := flag.NewFlagSet("promote", flag.ContinueOnError)
 	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	force := cmdFlags.Bool("force", false, "Promote regardless of health score")
+	ping := cmdFlags.Bool("ping", false, "Ping the floating IPs once active")
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
@@ -56,7 +62,7 @@ func (c *PromoteCommand) Run(args []string) int {
 		c.Ui.Error(c.Help())
 		return 1
 	}
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 	if err != nil {
 		c.Ui.Error("GRPC client connection error")
 		c.Ui.Error(err.Error())
@@ -67,11 +73,20 @@ func (c *PromoteCommand) Run(args []string) int {
 
 	r, err := client.Promote(context.Background(), &rpc.PromoteRequest{
 		Member: addr[0],
+		Force:  *force,
+		Ping:   *ping,
 	})
 	if err != nil {
 		c.Ui.Output("PulseHA CLI connection error. Is the PulseHA service running?")
 		c.Ui.Output(err.Error())
 	} else {
+		for _, step := range r.Steps {
+			mark := "\u2713"
+			if !step.Success {
+				mark = "x"
+			}
+			c.Ui.Output("[" + mark + "] " + step.Name + ": " + step.Message)
+		}
 		if r.Success {
 			c.Ui.Output("\n[\u2713] " + r.Message + "\n")
 		} else {
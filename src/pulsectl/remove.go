@@ -61,7 +61,7 @@ func (c *RemoveCommand) Run(args []string) int {
 		c.Ui.Error(c.Help())
 		return 1
 	}
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 
 	if err != nil {
 		c.Ui.Error("GRPC client connection error. Is the PulseHA service running?")
@@ -50,7 +50,7 @@ func (c *StatusCommand) Run(args []string) int {
 	cmdFlags := flag.NewFlagSet("status", flag.ContinueOnError)
 	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
 
-	connection, err := grpc.Dial("127.0.0.1:49152", grpc.WithInsecure())
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
 	if err != nil {
 		c.Ui.Error("GRPC client connection error")
 		c.Ui.Error(err.Error())
@@ -84,6 +84,8 @@ func (c *StatusCommand) drawStatusTable(client rpc.CLIClient) {
 					node.Status.String(),
 					strconv.Itoa(int(node.Score)),
 					node.LastReceived,
+					strings.Join(node.Groups, "\n"),
+					node.Config,
 				})
 		}
 		table := tablewriter.NewWriter(os.Stdout)
@@ -94,6 +96,8 @@ func (c *StatusCommand) drawStatusTable(client rpc.CLIClient) {
 			"Status",
 			"Score",
 			"Last Received",
+			"Groups",
+			"Config",
 		})
 		table.SetCenterSeparator("-")
 		table.SetColumnSeparator("|")
@@ -101,9 +105,88 @@ func (c *StatusCommand) drawStatusTable(client rpc.CLIClient) {
 		table.SetAutoMergeCells(false)
 		table.AppendBulk(data)
 		table.Render()
+		c.drawReachabilityTable(r.Row)
+		c.drawPathsTable(r.Row)
 	}
 }
 
+/**
+ * Draws the status of each heartbeat path when a node has more than one.
+ */
+func (c *StatusCommand) drawPathsTable(rows []*rpc.StatusRow) {
+	multiple := false
+	data := [][]string{}
+	for _, row := range rows {
+		if len(row.Paths) > 1 {
+			multiple = true
+		}
+		for _, path := range row.Paths {
+			status := "down"
+			if path.Up {
+				status = "up"
+			}
+			data = append(data, []string{row.Hostname, path.Address, status, path.Latency})
+		}
+	}
+	if !multiple {
+		return
+	}
+	c.Ui.Output("\nHeartbeat Paths")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Node Hostname", "Address", "Status", "Latency"})
+	table.SetCenterSeparator("-")
+	table.SetColumnSeparator("|")
+	table.SetRowLine(true)
+	table.SetAutoMergeCells(true)
+	table.AppendBulk(data)
+	table.Render()
+}
+
+/**
+ * Draws the reachability matrix when mesh health checks are enabled.
+ */
+func (c *StatusCommand) drawReachabilityTable(rows []*rpc.StatusRow) {
+	mesh := false
+	header := []string{"From / To"}
+	for _, row := range rows {
+		header = append(header, row.Hostname)
+		if len(row.Reachable) > 0 {
+			mesh = true
+		}
+	}
+	if !mesh {
+		return
+	}
+	data := [][]string{}
+	for _, from := range rows {
+		reachable := map[string]bool{}
+		for _, hostname := range from.Reachable {
+			reachable[hostname] = true
+		}
+		line := []string{from.Hostname}
+		for _, to := range rows {
+			switch {
+			case to.Hostname == from.Hostname:
+				line = append(line, "-")
+			case reachable[to.Hostname]:
+				line = append(line, "\u2713")
+			default:
+				line = append(line, "x")
+			}
+		}
+		data = append(data, line)
+	}
+	c.Ui.Output("\nReachability")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.SetCenterSeparator("-")
+	table.SetColumnSeparator("|")
+	table.SetRowLine(true)
+	table.SetAutoMergeCells(false)
+	table.AppendBulk(data)
+	table.Render()
+}
+
 /**
  *
  */
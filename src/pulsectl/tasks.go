@@ -0,0 +1,108 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulsectl
+
+import (
+	"context"
+	"flag"
+	"github.com/mitchellh/cli"
+	"github.com/olekukonko/tablewriter"
+	"github.com/syleron/pulseha/rpc"
+	"google.golang.org/grpc"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type TasksCommand struct {
+	Ui cli.Ui
+}
+
+/**
+ *
+ */
+func (c *TasksCommand) Help() string {
+	helpText := `
+Usage: pulsectl tasks
+  Lists the background tasks running on the local node.
+`
+	return strings.TrimSpace(helpText)
+}
+
+/**
+ *
+ */
+func (c *TasksCommand) Run(args []string) int {
+	cmdFlags := flag.NewFlagSet("tasks", flag.ContinueOnError)
+	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	connection, err := grpc.Dial(cliAddress(), grpc.WithInsecure())
+	if err != nil {
+		c.Ui.Error("GRPC client connection error")
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer connection.Close()
+	client := rpc.NewCLIClient(connection)
+
+	r, err := client.Tasks(context.Background(), &rpc.TasksRequest{})
+	if err != nil {
+		c.Ui.Output("PulseHA CLI connection error")
+		c.Ui.Output(err.Error())
+		return 1
+	}
+	if !r.Success {
+		c.Ui.Output("\n[x] " + r.Message + "\n")
+		return 1
+	}
+	data := [][]string{}
+	for _, task := range r.Row {
+		data = append(
+			data,
+			[]string{
+				task.Name,
+				task.Interval,
+				task.Started,
+				strconv.FormatUint(task.Runs, 10),
+			})
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{
+		"Task",
+		"Interval",
+		"Started",
+		"Runs",
+	})
+	table.SetCenterSeparator("-")
+	table.SetColumnSeparator("|")
+	table.SetRowLine(true)
+	table.SetAutoMergeCells(false)
+	table.AppendBulk(data)
+	table.Render()
+
+	return 0
+}
+
+/**
+ *
+ */
+func (c *TasksCommand) Synopsis() string {
+	return "Lists the background tasks running on the local node"
+}
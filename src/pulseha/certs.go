@@ -0,0 +1,410 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"errors"
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/packages/security"
+	"github.com/syleron/pulseha/rpc"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// signCertificate signs a member certificate with the cluster CA.
+// Only designated members hold the CA key so when we don't, the request is forwarded to a member that does.
+// Note: The token hash is only set for a new member so the member holding the CA key can check it joined with the cluster token.
+func signCertificate(csr []byte, ip string, hostname string, tokenHash string) ([]byte, error) {
+	if security.External() {
+		return nil, security.ErrExternalCA
+	}
+	if security.HasCAKey() {
+		return security.SignCSR(csr, ip, hostname)
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range DB.MemberList.GetMembers() {
+		if member.GetHostname() == localNode.Hostname {
+			continue
+		}
+		if err := member.Connect(); err != nil {
+			continue
+		}
+		resp, err := member.Send(client.SendSignCertificate, &rpc.SignCertificateRequest{
+			Hostname:  hostname,
+			Ip:        ip,
+			Csr:       csr,
+			TokenHash: tokenHash,
+		})
+		if err != nil {
+			DB.Logging.Debug("Unable to request a certificate from " + member.GetHostname() + ": " + err.Error())
+			continue
+		}
+		if !resp.(*rpc.SignCertificateResponse).Success {
+			DB.Logging.Debug(member.GetHostname() + " did not sign the certificate: " + resp.(*rpc.SignCertificateResponse).Message)
+			continue
+		}
+		return []byte(resp.(*rpc.SignCertificateResponse).Crt), nil
+	}
+	return nil, errors.New("no member holding the cluster CA key was able to sign the certificate")
+}
+
+// requestCertificate replaces our certificate with a new one signed by the cluster CA.
+func requestCertificate(ip string, hostname string) error {
+	csr, key, err := security.GenerateCSR(hostname)
+	if err != nil {
+		return err
+	}
+	crt, err := signCertificate(csr, ip, hostname, "")
+	if err != nil {
+		return err
+	}
+	if err := security.WriteCertAndKeyFiles(security.CertName, crt, key); err != nil {
+		return err
+	}
+	return security.Reload()
+}
+
+// renewCertificate renews our certificate when it is about to expire.
+// Returns false so our task keeps running.
+func renewCertificate(ctx context.Context) bool {
+	cert, _, err := security.LocalCertificates()
+	if err != nil {
+		DB.Logging.Warn("Unable to check the expiry of our certificate: " + err.Error())
+		return false
+	}
+	if time.Until(cert.NotAfter) > security.RenewBefore {
+		return false
+	}
+	// Certificates issued by an external CA are renewed by replacing the files
+	if security.External() {
+		DB.Logging.Warn("Our certificate expires " + cert.NotAfter.Format(time.RFC3339) + ". Please renew it with the external CA")
+		return false
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return false
+	}
+	DB.Logging.Info("Renewing our certificate as it expires " + cert.NotAfter.Format(time.RFC3339))
+	if err := requestCertificate(localNode.IP, localNode.Hostname); err != nil {
+		DB.Logging.Warn("Unable to renew our certificate: " + err.Error())
+	}
+	return false
+}
+
+// reloadCertificates reloads our certificate, the CAs we trust and our revocation list when the files change.
+// Returns false so our task keeps running.
+func reloadCertificates(ctx context.Context) bool {
+	if !security.Changed() {
+		return false
+	}
+	if err := security.Reload(); err != nil {
+		DB.Logging.Warn("Unable to reload our certificates: " + err.Error())
+		return false
+	}
+	DB.Logging.Info("Reloaded our certificates as they changed on disk")
+	return false
+}
+
+// certInfoToRPC converts a certificate description to its RPC form.
+func certInfoToRPC(info security.CertInfo) *rpc.CertificateInfo {
+	return &rpc.CertificateInfo{
+		Subject:  info.Subject,
+		Serial:   info.Serial,
+		NotAfter: info.NotAfter.Format(time.RFC3339),
+	}
+}
+
+// certificateStatus describes our certificate and the CAs we trust.
+func certificateStatus() *rpc.CertificateStatusResponse {
+	cert, cas, err := security.LocalCertificates()
+	if err != nil {
+		return &rpc.CertificateStatusResponse{
+			Success: false,
+			Message: err.Error(),
+		}
+	}
+	resp := &rpc.CertificateStatusResponse{
+		Success:   true,
+		Cert:      certInfoToRPC(cert),
+		CaKey:     security.HasCAKey(),
+		CrlNumber: security.CRLNumber(),
+	}
+	for _, ca := range cas {
+		resp.Cas = append(resp.Cas, certInfoToRPC(ca))
+	}
+	return resp
+}
+
+// fetchCertificateStatus gets the certificates of a member.
+func fetchCertificateStatus(member *Member) (*rpc.CertificateStatusResponse, error) {
+	if err := member.Connect(); err != nil {
+		return nil, err
+	}
+	r, err := member.Send(client.SendCertificateStatus, &rpc.CertificateStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp := r.(*rpc.CertificateStatusResponse)
+	if !resp.Success {
+		return nil, errors.New(resp.Message)
+	}
+	return resp, nil
+}
+
+// certStatusRows lists the certificate of every member followed by the CAs we trust.
+func certStatusRows() []*rpc.CertStatusRow {
+	localNode, _ := DB.Config.GetLocalNode()
+	local := certificateStatus()
+	rows := []*rpc.CertStatusRow{}
+	for _, member := range DB.MemberList.GetMembers() {
+		resp := local
+		var err error
+		if member.GetHostname() != localNode.Hostname {
+			resp, err = fetchCertificateStatus(member)
+		} else if !local.Success {
+			err = errors.New(local.Message)
+		}
+		row := &rpc.CertStatusRow{
+			Hostname: member.GetHostname(),
+		}
+		if err != nil {
+			row.Error = err.Error()
+		} else {
+			row.Cert = resp.Cert
+			row.CaKey = resp.CaKey
+			row.CrlNumber = resp.CrlNumber
+		}
+		rows = append(rows, row)
+	}
+	for _, ca := range local.Cas {
+		rows = append(rows, &rpc.CertStatusRow{
+			Cert: ca,
+		})
+	}
+	return rows
+}
+
+// sendCertUpdate sends a CA, revocation list or renewal request to a member.
+func sendCertUpdate(member *Member, update *rpc.CertUpdateRequest) error {
+	if err := member.Connect(); err != nil {
+		return err
+	}
+	r, err := member.Send(client.SendCertUpdate, update)
+	if err != nil {
+		return err
+	}
+	if !r.(*rpc.CertUpdateResponse).Success {
+		return errors.New(r.(*rpc.CertUpdateResponse).Message)
+	}
+	return nil
+}
+
+// otherMembers returns every member but ourselves.
+func otherMembers() []*Member {
+	localNode, _ := DB.Config.GetLocalNode()
+	members := []*Member{}
+	for _, member := range DB.MemberList.GetMembers() {
+		if member.GetHostname() != localNode.Hostname {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// updateMembers sends an update to every member and returns the hostnames of the members that failed.
+func updateMembers(members []*Member, update func(member *Member) *rpc.CertUpdateRequest) []string {
+	failed := []string{}
+	for _, member := range members {
+		req := update(member)
+		if req == nil {
+			continue
+		}
+		if err := sendCertUpdate(member, req); err != nil {
+			DB.Logging.Warn("Unable to update the certificates of " + member.GetHostname() + ": " + err.Error())
+			failed = append(failed, member.GetHostname())
+		}
+	}
+	return failed
+}
+
+// rotateCA replaces the cluster CA.
+// Every member trusts the new CA before it signs anything and the old CAs are only retired once every member has renewed its certificate.
+// When finish is set an interrupted rotation is finished instead of starting a new one.
+func rotateCA(finish bool) error {
+	if security.External() {
+		return security.ErrExternalCA
+	}
+	if !security.HasCAKey() {
+		return errors.New("the CA can only be rotated from a node that holds ca.key")
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return err
+	}
+	members := otherMembers()
+	if !finish {
+		bundle, key, err := security.NewCA(localNode.IP)
+		if err != nil {
+			return err
+		}
+		signature, err := security.SignTrustBundle(bundle)
+		if err != nil {
+			return err
+		}
+		update := &rpc.CertUpdateRequest{
+			CaCrt:       string(bundle),
+			CaSignature: signature,
+		}
+		// Every member must trust the new CA before we start using it
+		if failed := updateMembers(members, func(*Member) *rpc.CertUpdateRequest { return update }); len(failed) > 0 {
+			return errors.New("unable to send the new CA to " + strings.Join(failed, ", ") + ". The CA has not been rotated")
+		}
+		if err := security.InstallTrustBundle(bundle, signature); err != nil {
+			return err
+		}
+		if err := security.InstallCAKey(key); err != nil {
+			return err
+		}
+		if _, err := security.ReissueCRL(); err != nil {
+			return err
+		}
+	}
+	if err := security.Reload(); err != nil {
+		return err
+	}
+	// Send the new CA key to the other members that sign certificates along with our revocation list
+	bundle, err := ioutil.ReadFile(security.CertDir + "ca.crt")
+	if err != nil {
+		return err
+	}
+	signature, err := security.SignTrustBundle(bundle)
+	if err != nil {
+		return err
+	}
+	key, err := security.CAKey()
+	if err != nil {
+		return err
+	}
+	crl, err := ioutil.ReadFile(security.CertDir + security.CRLName)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	failed := updateMembers(members, func(member *Member) *rpc.CertUpdateRequest {
+		update := &rpc.CertUpdateRequest{
+			Crl: string(crl),
+		}
+		if status, err := fetchCertificateStatus(member); err == nil && status.CaKey {
+			update.CaCrt = string(bundle)
+			update.CaSignature = signature
+			update.CaKey = string(key)
+		}
+		return update
+	})
+	// Renew every certificate with the new CA
+	if err := requestCertificate(localNode.IP, localNode.Hostname); err != nil {
+		return err
+	}
+	failed = append(failed, updateMembers(members, func(*Member) *rpc.CertUpdateRequest {
+		return &rpc.CertUpdateRequest{Renew: true}
+	})...)
+	if len(failed) > 0 {
+		return errors.New("unable to renew the certificates of " + strings.Join(failed, ", ") + ". The old CA is still trusted. Please finish the rotation once they are available")
+	}
+	// Retire the old CAs
+	bundle, err = security.RetireCAs()
+	if err != nil {
+		return err
+	}
+	signature, err = security.SignTrustBundle(bundle)
+	if err != nil {
+		return err
+	}
+	if failed := updateMembers(members, func(*Member) *rpc.CertUpdateRequest {
+		return &rpc.CertUpdateRequest{
+			CaCrt:       string(bundle),
+			CaSignature: signature,
+		}
+	}); len(failed) > 0 {
+		return errors.New("unable to retire the old CA on " + strings.Join(failed, ", ") + ". Please finish the rotation once they are available")
+	}
+	return security.Reload()
+}
+
+// revokeCertificate revokes a certificate and sends our revocation list to every member.
+func revokeCertificate(serial string) error {
+	if security.External() {
+		return security.ErrExternalCA
+	}
+	if !security.HasCAKey() {
+		return errors.New("certificates can only be revoked from a node that holds ca.key")
+	}
+	crl, err := security.Revoke(serial)
+	if err != nil {
+		return err
+	}
+	if err := security.Reload(); err != nil {
+		return err
+	}
+	if failed := updateMembers(otherMembers(), func(*Member) *rpc.CertUpdateRequest {
+		return &rpc.CertUpdateRequest{Crl: string(crl)}
+	}); len(failed) > 0 {
+		return errors.New("the certificate has been revoked but the revocation list couldn't be sent to " + strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// applyCertUpdate applies a CA, revocation list or renewal request sent by a member.
+// Note: The CAs must be signed by a CA we already trust and the revocation list must be signed by one we trust.
+func applyCertUpdate(update *rpc.CertUpdateRequest) error {
+	if update.CaCrt != "" {
+		if err := security.InstallTrustBundle([]byte(update.CaCrt), update.CaSignature); err != nil {
+			return err
+		}
+		DB.Logging.Info("Updated the cluster CA certificates")
+		if update.CaKey != "" {
+			if err := security.InstallCAKey([]byte(update.CaKey)); err != nil {
+				return err
+			}
+			DB.Logging.Info("Updated the cluster CA key")
+		}
+	}
+	if update.Crl != "" {
+		if err := security.InstallCRL([]byte(update.Crl)); err != nil {
+			return err
+		}
+	}
+	if err := security.Reload(); err != nil {
+		return err
+	}
+	if update.Renew {
+		localNode, err := DB.Config.GetLocalNode()
+		if err != nil {
+			return err
+		}
+		if err := requestCertificate(localNode.IP, localNode.Hostname); err != nil {
+			return err
+		}
+		DB.Logging.Info("Renewed our certificate")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"github.com/syleron/pulseha/packages/security"
+	"github.com/syleron/pulseha/rpc"
+	"testing"
+	"time"
+)
+
+// setupTestCerts generates a cluster CA and a certificate for node1 in a temporary folder.
+func setupTestCerts(t *testing.T) {
+	certDir := security.CertDir
+	security.CertDir = t.TempDir() + "/"
+	t.Cleanup(func() {
+		security.CertDir = certDir
+	})
+	if err := security.GenerateCACert("10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.GenTLSKeys("10.0.0.1", "node1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRenewCertificate(t *testing.T) {
+	setupTestPaths()
+	setupTestCerts(t)
+	before, _, _ := security.LocalCertificates()
+	renewCertificate(context.Background())
+	if after, _, _ := security.LocalCertificates(); after.Serial != before.Serial {
+		t.Error("expected our certificate not to be renewed before it is due")
+	}
+	renewBefore := security.RenewBefore
+	security.RenewBefore = security.CertValidity
+	defer func() {
+		security.RenewBefore = renewBefore
+	}()
+	renewCertificate(context.Background())
+	after, _, _ := security.LocalCertificates()
+	if after.Serial == before.Serial {
+		t.Fatal("expected our certificate to be renewed when it is due")
+	}
+	if after.Subject != "node1" || time.Until(after.NotAfter) <= security.CertValidity-time.Hour {
+		t.Errorf("unexpected renewed certificate %+v", after)
+	}
+}
+
+func TestApplyCertUpdateRequiresTrustedCA(t *testing.T) {
+	setupTestPaths()
+	setupTestCerts(t)
+	bundle, _, err := security.NewCA("10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := applyCertUpdate(&rpc.CertUpdateRequest{
+		CaCrt:       string(bundle),
+		CaSignature: []byte("invalid"),
+	}); err == nil {
+		t.Error("expected a CA that isn't signed by a trusted CA to be rejected")
+	}
+	if _, cas, _ := security.LocalCertificates(); len(cas) != 1 {
+		t.Errorf("expected our CAs to be unchanged, got %d", len(cas))
+	}
+}
+
+func TestSignCertificateForNewMemberRequiresToken(t *testing.T) {
+	setupTestPaths()
+	setupTestCerts(t)
+	DB.Config.Pulse.ClusterToken = security.GenerateSHA256Hash("token")
+	csr, _, err := security.GenerateCSR("node3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{}
+	for _, tokenHash := range []string{"", security.GenerateSHA256Hash("other")} {
+		if _, err := s.SignCertificate(peerContext("node2", true), &rpc.SignCertificateRequest{
+			Hostname:  "node3",
+			Ip:        "10.0.0.3",
+			Csr:       csr,
+			TokenHash: tokenHash,
+		}); err == nil {
+			t.Errorf("expected a certificate for a new member to be refused with token hash %q", tokenHash)
+		}
+	}
+	resp, err := s.SignCertificate(peerContext("node2", true), &rpc.SignCertificateRequest{
+		Hostname:  "node3",
+		Ip:        "10.0.0.3",
+		Csr:       csr,
+		TokenHash: DB.Config.Pulse.ClusterToken,
+	})
+	if err != nil || !resp.Success {
+		t.Errorf("expected a forwarded join to be signed, got %v %v", resp, err)
+	}
+}
@@ -18,6 +18,7 @@ package pulseha
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	log "github.com/sirupsen/logrus"
 	"github.com/syleron/pulseha/packages/client"
@@ -34,6 +35,9 @@ import (
 	"time"
 )
 
+// CLIAddress is the address the CLI server listens on.
+var CLIAddress = config.DefaultCLIAddress
+
 // CLIServer CLI server object
 type CLIServer struct {
 	sync.Mutex
@@ -43,8 +47,8 @@ type CLIServer struct {
 
 // Setup is used to bootstrap the cli server.
 func (s *CLIServer) Setup() {
-	log.Info("CLI server initialised on 127.0.0.1:49152")
-	lis, err := net.Listen("tcp", "127.0.0.1:49152")
+	log.Info("CLI server initialised on " + CLIAddress)
+	lis, err := net.Listen("tcp", CLIAddress)
 	if err != nil {
 		log.Errorf("Failed to listen: %s", err)
 		// TODO: Note: We exit because the service is useless without the CLI server running
@@ -120,10 +124,35 @@ func (s *CLIServer) Join(ctx context.Context, in *rpc.JoinRequest) (*rpc.JoinRes
 				ErrorCode: 2,
 			}, nil
 		}
+		var csr []byte
+		var key crypto.Signer
+		if security.External() {
+			// Our certificate is issued by an external CA so make sure it identifies us before joining
+			if err := security.CheckPeerCert(newNode.Hostname); err != nil {
+				log.Errorf("Join() TLS certificate is not valid for %s: %s", newNode.Hostname, err)
+				return &rpc.JoinResponse{
+					Success:   false,
+					Message:   "TLS certificate is not valid for " + newNode.Hostname + ": " + err.Error(),
+					ErrorCode: 6,
+				}, nil
+			}
+		} else {
+			// Generate our key and ask the cluster to sign our certificate
+			csr, key, err = security.GenerateCSR(newNode.Hostname)
+			if err != nil {
+				log.Errorf("Join() Unable to generate certificate signing request: %s", err)
+				return &rpc.JoinResponse{
+					Success:   false,
+					Message:   err.Error(),
+					ErrorCode: 6,
+				}, nil
+			}
+		}
 		r, err := c.Send(client.SendJoin, &rpc.JoinRequest{
 			Config: buf,
 			Uid:    uid,
 			Token:  in.Token,
+			Csr:    csr,
 		})
 		// Handle a failed request
 		if err != nil {
@@ -144,18 +173,27 @@ func (s *CLIServer) Join(ctx context.Context, in *rpc.JoinRequest) (*rpc.JoinRes
 				ErrorCode: 5,
 			}, nil
 		}
-		// write CA keys
-		utils.CreateFolder(security.CertDir)
-		security.WriteCertFile("ca", []byte(r.(*rpc.JoinResponse).CaCrt))
-		security.WriteKeyFile("ca", []byte(r.(*rpc.JoinResponse).CaKey))
-		// Generate our new keys
-		if err := security.GenTLSKeys(in.BindIp); err != nil {
-			log.Errorf("Join() Unable to generate TLS keys: %s", err)
-			return &rpc.JoinResponse{
-				Success:   false,
-				Message:   err.Error(),
-				ErrorCode: 6,
-			}, nil
+		// Write the CA certificate and our signed certificate.
+		// Note: The CA key is never sent to joining nodes.
+		if !security.External() {
+			utils.CreateFolder(security.CertDir)
+			err = security.WriteCertFile("ca", []byte(r.(*rpc.JoinResponse).CaCrt))
+			if err == nil {
+				err = security.WriteCertAndKeyFiles(security.CertName, []byte(r.(*rpc.JoinResponse).Crt), key)
+			}
+			if err != nil {
+				log.Errorf("Join() Unable to write TLS keys: %s", err)
+				return &rpc.JoinResponse{
+					Success:   false,
+					Message:   err.Error(),
+					ErrorCode: 6,
+				}, nil
+			}
+			if crl := r.(*rpc.JoinResponse).Crl; crl != "" {
+				if err := security.InstallCRL([]byte(crl)); err != nil {
+					log.Errorf("Join() Unable to install the revocation list: %s", err)
+				}
+			}
 		}
 		// Update our local config
 		peerConfig := &config.Config{}
@@ -190,6 +228,7 @@ func (s *CLIServer) Join(ctx context.Context, in *rpc.JoinRequest) (*rpc.JoinRes
 		// reset our HC last received time
 		localMember, _ := DB.MemberList.GetLocalMember()
 		localMember.SetLastHCResponse(time.Now())
+		DB.Detector.Reset()
 		// Close the connection
 		c.Close()
 		log.Info("Successfully joined cluster with " + in.Ip)
@@ -252,7 +291,8 @@ func (s *CLIServer) Leave(ctx context.Context, in *rpc.LeaveRequest) (*rpc.Leave
 		}, nil
 	}
 	// Remove our generated keys
-	if !utils.DeleteFolder(security.CertDir) {
+	// Note: Certificates issued by an external CA are left in place.
+	if !security.External() && !utils.DeleteFolder(security.CertDir) {
 		log.Warn("Failed to remove certs directory. Please manually remove hanging certs.")
 	}
 	// yay?
@@ -322,7 +362,20 @@ func (s *CLIServer) Remove(ctx context.Context, in *rpc.RemoveRequest) (*rpc.Rem
 	}
 	// Set our member status
 	member := DB.MemberList.GetMemberByHostname(in.Hostname)
-	member.SetStatus(rpc.MemberStatus_LEAVING)
+	if member == nil {
+		return &rpc.RemoveResponse{
+			Success:   false,
+			Message:   in.Hostname + " is not a member of the cluster",
+			ErrorCode: 3,
+		}, nil
+	}
+	if err := member.SetStatus(rpc.MemberStatus_LEAVING); err != nil {
+		return &rpc.RemoveResponse{
+			Success:   false,
+			Message:   "Unable to remove " + in.Hostname + ": " + err.Error(),
+			ErrorCode: 3,
+		}, nil
+	}
 	// Check if I am the node being removed
 	if in.Hostname == localNode.Hostname {
 		s.Server.Shutdown()
@@ -372,7 +425,7 @@ func (s *CLIServer) Create(ctx context.Context, in *rpc.CreateRequest) (*rpc.Cre
 		nodesClearLocal()
 		groupClearLocal()
 		// Create a new local node config
-		_, _, err := nodeCreateLocal(in.BindIp, in.BindPort, true)
+		_, localNode, err := nodeCreateLocal(in.BindIp, in.BindPort, true)
 		if err != nil {
 			return &rpc.CreateResponse{
 				Success:   false,
@@ -387,15 +440,37 @@ func (s *CLIServer) Create(ctx context.Context, in *rpc.CreateRequest) (*rpc.Cre
 		token_hash := security.GenerateSHA256Hash(token)
 		// Set our token in our config
 		DB.Config.Pulse.ClusterToken = token_hash
+		// Generate the key our members sign UDP health checks with
+		DB.Config.SetHeartbeatKey(generateRandomString(32))
 		// Save back to our config
 		if err := DB.Config.Save(); err != nil {
 			panic(err)
 		}
 		// Cert stuff
-		security.GenerateCACert(in.BindIp)
-		// Generate client server keys if tls is enabled
-		if err := security.GenTLSKeys(in.BindIp); err != nil {
-			panic(err)
+		if security.External() {
+			// Our certificate is issued by an external CA so make sure it identifies us
+			if err := security.CheckPeerCert(localNode.Hostname); err != nil {
+				log.Errorf("Create() TLS certificate is not valid for %s: %s", localNode.Hostname, err)
+				return &rpc.CreateResponse{
+					Success:   false,
+					Message:   "TLS certificate is not valid for " + localNode.Hostname + ": " + err.Error(),
+					ErrorCode: 4,
+				}, nil
+			}
+		} else {
+			err = security.GenerateCACert(in.BindIp)
+			if err == nil {
+				// Generate client server keys if tls is enabled
+				err = security.GenTLSKeys(in.BindIp, localNode.Hostname)
+			}
+			if err != nil {
+				log.Errorf("Create() Unable to generate TLS keys: %s", err)
+				return &rpc.CreateResponse{
+					Success:   false,
+					Message:   "Unable to generate TLS keys: " + err.Error(),
+					ErrorCode: 4,
+				}, nil
+			}
 		}
 		// Setup our pulse server
 		go s.Server.Setup()
@@ -677,6 +752,42 @@ func (s *CLIServer) GroupUnassign(ctx context.Context, in *rpc.GroupUnassignRequ
 	}, nil
 }
 
+// GroupPlace command is used to place a floating ip group on a particular node.
+func (s *CLIServer) GroupPlace(ctx context.Context, in *rpc.GroupPlaceRequest) (*rpc.GroupPlaceResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !DB.Config.ClusterCheck() {
+		return &rpc.GroupPlaceResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	if err := groupPlace(in.Group, in.Node); err != nil {
+		return &rpc.GroupPlaceResponse{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 2,
+		}, nil
+	}
+	if err := DB.Config.Save(); err != nil {
+		log.Error("Unable to save local config. This likely means the local config is now out of date.")
+	}
+	DB.MemberList.SyncConfig()
+	// Bring up or down the group locally
+	reconcileLocalGroups(false)
+	if in.Node == "" {
+		return &rpc.GroupPlaceResponse{
+			Success: true,
+			Message: in.Group + " now follows the active node",
+		}, nil
+	}
+	return &rpc.GroupPlaceResponse{
+		Success: true,
+		Message: in.Group + " placed on node " + in.Node,
+	}, nil
+}
+
 // GroupList command is used to list the available floating ip groups on the current node.
 func (s *CLIServer) GroupList(ctx context.Context, in *rpc.GroupTableRequest) (*rpc.GroupTableResponse, error) {
 	s.Lock()
@@ -690,7 +801,14 @@ func (s *CLIServer) GroupList(ctx context.Context, in *rpc.GroupTableRequest) (*
 	table := new(rpc.GroupTableResponse)
 	for name, ips := range DB.Config.Groups {
 		nodes, interfaces := getGroupNodes(name)
-		row := &rpc.GroupRow{Name: name, Ip: ips, Nodes: nodes, Interfaces: interfaces}
+		row := &rpc.GroupRow{
+			Name:       name,
+			Ip:         ips,
+			Nodes:      nodes,
+			Interfaces: interfaces,
+			Owner:      groupOwner(name),
+			Placement:  DB.Config.GroupOwners[name],
+		}
 		table.Row = append(table.Row, row)
 	}
 	return table, nil
@@ -707,6 +825,10 @@ func (s *CLIServer) Status(ctx context.Context, in *rpc.StatusRequest) (*rpc.Sta
 		}, nil
 	}
 	table := new(rpc.StatusResponse)
+	var matrix map[string][]string
+	if localNode, err := DB.Config.GetLocalNode(); err == nil && meshEnabled() {
+		matrix = DB.Mesh.Matrix(localNode.Hostname)
+	}
 	for _, member := range DB.MemberList.Members {
 		_, node, _ := nodeGetByHostname(member.Hostname)
 		tym := member.GetLastHCResponse()
@@ -723,6 +845,16 @@ func (s *CLIServer) Status(ctx context.Context, in *rpc.StatusRequest) (*rpc.Sta
 			Status:       member.GetStatus(),
 			LastReceived: tymFormat,
 			Score:        int32(member.GetScore()),
+			Groups:       memberGroups(member.GetHostname()),
+			Reachable:    matrix[member.GetHostname()],
+			Config:       configState(member),
+		}
+		for _, path := range member.GetPaths() {
+			row.Paths = append(row.Paths, &rpc.PathStatus{
+				Address: path.Address,
+				Up:      path.Up,
+				Latency: path.Latency,
+			})
 		}
 		table.Row = append(table.Row, row)
 	}
@@ -741,17 +873,19 @@ func (s *CLIServer) Promote(ctx context.Context, in *rpc.PromoteRequest) (*rpc.P
 			ErrorCode: 1,
 		}, nil
 	}
-	err := DB.MemberList.PromoteMember(in.Member)
+	steps, err := DB.MemberList.Switchover(ctx, in.Member, in.Force, in.Ping)
 	if err != nil {
 		return &rpc.PromoteResponse{
 			Success:   false,
 			Message:   err.Error(),
 			ErrorCode: 2,
+			Steps:     steps,
 		}, nil
 	}
 	return &rpc.PromoteResponse{
 		Success: true,
 		Message: "Successfully promoted member " + in.Member,
+		Steps:   steps,
 	}, nil
 }
 
@@ -766,7 +900,10 @@ func (s *CLIServer) TLS(ctx context.Context, in *rpc.CertRequest) (*rpc.CertResp
 			ErrorCode: 1,
 		}, nil
 	}
-	err := security.GenTLSKeys(in.BindIp)
+	localNode, err := DB.Config.GetLocalNode()
+	if err == nil {
+		err = requestCertificate(in.BindIp, localNode.Hostname)
+	}
 	if err != nil {
 		return &rpc.CertResponse{
 			Success:   false,
@@ -856,6 +993,8 @@ func (s *CLIServer) Token(ctx context.Context, in *rpc.TokenRequest) (*rpc.Token
 	token_hash := security.GenerateSHA256Hash(token)
 	// Set our token in our config
 	DB.Config.Pulse.ClusterToken = token_hash
+	// Clusters created by older versions of PulseHA need a heartbeat key
+	ensureHeartbeatKey()
 	// Sync our config with the cluster
 	if err := DB.MemberList.SyncConfig(); err != nil {
 		return &rpc.TokenResponse{
@@ -910,3 +1049,296 @@ func (s *CLIServer) Network(ctx context.Context, in *rpc.PulseNetwork) (*rpc.Pul
 func (s *CLIServer) Describe(ctx context.Context, in *rpc.DescribeRequest) (*rpc.DescribeResponse, error) {
 	return &rpc.DescribeResponse{}, nil
 }
+
+// Tasks lists the background tasks running on our local node
+func (s *CLIServer) Tasks(ctx context.Context, in *rpc.TasksRequest) (*rpc.TasksResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	resp := &rpc.TasksResponse{
+		Success: true,
+	}
+	for _, task := range DB.Supervisor.List() {
+		resp.Row = append(resp.Row, &rpc.TaskRow{
+			Name:     task.Name,
+			Interval: task.Interval.String(),
+			Started:  task.Started.Format(time.RFC1123),
+			Runs:     task.Runs,
+		})
+	}
+	return resp, nil
+}
+
+// Node updates the settings of a node in our cluster
+func (s *CLIServer) Node(ctx context.Context, in *rpc.NodeRequest) (*rpc.NodeResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !DB.Config.ClusterCheck() {
+		return &rpc.NodeResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	switch in.Action {
+	case "monitoring":
+		var enabled bool
+		switch in.Value {
+		case "on":
+			enabled = true
+		case "off":
+			enabled = false
+		default:
+			return &rpc.NodeResponse{
+				Success:   false,
+				Message:   "monitoring must be either on or off",
+				ErrorCode: 2,
+			}, nil
+		}
+		if err := nodeSetMonitoring(in.Hostname, enabled); err != nil {
+			return &rpc.NodeResponse{
+				Success:   false,
+				Message:   err.Error(),
+				ErrorCode: 3,
+			}, nil
+		}
+		DB.MemberList.RefreshStandbyStatus()
+	case "priority":
+		priority, err := strconv.Atoi(in.Value)
+		if err != nil {
+			return &rpc.NodeResponse{
+				Success:   false,
+				Message:   "priority must be a whole number",
+				ErrorCode: 2,
+			}, nil
+		}
+		if err := nodeSetPriority(in.Hostname, priority); err != nil {
+			return &rpc.NodeResponse{
+				Success:   false,
+				Message:   err.Error(),
+				ErrorCode: 3,
+			}, nil
+		}
+	case "preferred":
+		var preferred bool
+		switch in.Value {
+		case "on":
+			preferred = true
+		case "off":
+			preferred = false
+		default:
+			return &rpc.NodeResponse{
+				Success:   false,
+				Message:   "preferred must be either on or off",
+				ErrorCode: 2,
+			}, nil
+		}
+		if err := nodeSetPreferred(in.Hostname, preferred); err != nil {
+			return &rpc.NodeResponse{
+				Success:   false,
+				Message:   err.Error(),
+				ErrorCode: 3,
+			}, nil
+		}
+	default:
+		return &rpc.NodeResponse{
+			Success:   false,
+			Message:   "unknown node action " + in.Action,
+			ErrorCode: 2,
+		}, nil
+	}
+	// Sync it with our peers
+	if err := DB.MemberList.SyncConfig(); err != nil {
+		return &rpc.NodeResponse{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 4,
+		}, nil
+	}
+	return &rpc.NodeResponse{
+		Success: true,
+		Message: "Successfully updated node " + in.Hostname,
+	}, nil
+}
+
+// Maintenance moves a node in or out of maintenance
+func (s *CLIServer) Maintenance(ctx context.Context, in *rpc.MaintenanceRequest) (*rpc.MaintenanceResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !DB.Config.ClusterCheck() {
+		return &rpc.MaintenanceResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	hostname := in.Hostname
+	if hostname == "" {
+		localNode, err := DB.Config.GetLocalNode()
+		if err != nil {
+			return &rpc.MaintenanceResponse{
+				Success:   false,
+				Message:   err.Error(),
+				ErrorCode: 2,
+			}, nil
+		}
+		hostname = localNode.Hostname
+	}
+	if err := nodeSetMaintenance(ctx, hostname, in.Enabled); err != nil {
+		return &rpc.MaintenanceResponse{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 3,
+		}, nil
+	}
+	if in.Enabled {
+		return &rpc.MaintenanceResponse{
+			Success: true,
+			Message: hostname + " is now in maintenance",
+		}, nil
+	}
+	return &rpc.MaintenanceResponse{
+		Success: true,
+		Message: hostname + " is no longer in maintenance",
+	}, nil
+}
+
+// ConfigVersions lists the config version of every node in our cluster
+func (s *CLIServer) ConfigVersions(ctx context.Context, in *rpc.ConfigVersionsRequest) (*rpc.ConfigVersionsResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !DB.Config.ClusterCheck() {
+		return &rpc.ConfigVersionsResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	return &rpc.ConfigVersionsResponse{
+		Success: true,
+		Row:     DB.Replication.Versions(),
+	}, nil
+}
+
+// ConfigHistory lists our local config backups
+func (s *CLIServer) ConfigHistory(ctx context.Context, in *rpc.ConfigHistoryRequest) (*rpc.ConfigHistoryResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	backups, err := config.Backups()
+	if err != nil {
+		return &rpc.ConfigHistoryResponse{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 1,
+		}, nil
+	}
+	rows := []*rpc.ConfigBackupRow{}
+	for _, backup := range backups {
+		rows = append(rows, &rpc.ConfigBackupRow{
+			Index:   int32(backup.Index),
+			Time:    backup.Time.Format(time.RFC3339),
+			Version: versionToRPC(backup.Version),
+		})
+	}
+	return &rpc.ConfigHistoryResponse{
+		Success: true,
+		Row:     rows,
+	}, nil
+}
+
+// ConfigRollback restores one of our local config backups
+func (s *CLIServer) ConfigRollback(ctx context.Context, in *rpc.ConfigRollbackRequest) (*rpc.ConfigRollbackResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if in.Replicate && !DB.Config.ClusterCheck() {
+		return &rpc.ConfigRollbackResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	if err := DB.Replication.Rollback(int(in.Index), in.Replicate); err != nil {
+		return &rpc.ConfigRollbackResponse{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 2,
+		}, nil
+	}
+	message := "Successfully restored config backup " + strconv.Itoa(int(in.Index))
+	if in.Replicate {
+		message += " on every node"
+	} else if DB.Config.ClusterCheck() {
+		message += " pulseha and plugins sections"
+	}
+	return &rpc.ConfigRollbackResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// CertStatus lists the certificate expiry of every node and the CAs we trust
+func (s *CLIServer) CertStatus(ctx context.Context, in *rpc.CertStatusRequest) (*rpc.CertStatusResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !DB.Config.ClusterCheck() {
+		return &rpc.CertStatusResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	return &rpc.CertStatusResponse{
+		Success: true,
+		Row:     certStatusRows(),
+	}, nil
+}
+
+// CertRotateCA rotates the cluster CA
+func (s *CLIServer) CertRotateCA(ctx context.Context, in *rpc.CertRotateCARequest) (*rpc.CertRotateCAResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !DB.Config.ClusterCheck() {
+		return &rpc.CertRotateCAResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	if err := rotateCA(in.Finish); err != nil {
+		DB.Logging.Error("Unable to rotate the CA: " + err.Error())
+		return &rpc.CertRotateCAResponse{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 2,
+		}, nil
+	}
+	DB.Logging.Info("Rotated the cluster CA")
+	return &rpc.CertRotateCAResponse{
+		Success: true,
+		Message: "Successfully rotated the cluster CA",
+	}, nil
+}
+
+// CertRevoke revokes a certificate
+func (s *CLIServer) CertRevoke(ctx context.Context, in *rpc.CertRevokeRequest) (*rpc.CertRevokeResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !DB.Config.ClusterCheck() {
+		return &rpc.CertRevokeResponse{
+			Success:   false,
+			Message:   language.CLUSTER_REQUIRED_MESSAGE,
+			ErrorCode: 1,
+		}, nil
+	}
+	if err := revokeCertificate(in.Serial); err != nil {
+		return &rpc.CertRevokeResponse{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 2,
+		}, nil
+	}
+	DB.Logging.Info("Revoked certificate " + in.Serial)
+	return &rpc.CertRevokeResponse{
+		Success: true,
+		Message: "Successfully revoked certificate " + in.Serial,
+	}, nil
+}
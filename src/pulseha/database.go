@@ -26,6 +26,16 @@ type Database struct {
 	Config        *config.Config
 	Plugins       *Plugins
 	MemberList    *MemberList
+	Election      *Election
+	Supervisor    *Supervisor
+	StateMachine  *StateMachine
+	Events        *EventLog
+	Placement     *Placement
+	SplitBrain    *SplitBrain
+	Detector      *Detector
+	Mesh          *Mesh
+	Transport     *HeartbeatTransport
+	Replication   *Replication
 	Logging       logging.Logging
 	StartDelay    bool
 	StartInterval int
@@ -0,0 +1,222 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/rpc"
+	"strconv"
+	"sync"
+)
+
+// Election defines our leader election object.
+// Each term elects at most one active member. A member only becomes active
+// through an election when a quorum of the configured cluster grants it their vote.
+type Election struct {
+	// The current election term
+	Term uint64
+	// The candidate we granted our vote to in the current term
+	VotedFor string
+	// The member we consider the active for the current term
+	Leader string
+	// The mutex to lock the election object
+	sync.Mutex
+}
+
+// GetTerm returns the current election term.
+func (e *Election) GetTerm() uint64 {
+	e.Lock()
+	defer e.Unlock()
+	return e.Term
+}
+
+// ObserveTerm adopts a newer term seen from another member.
+// Returns true when our term has been updated.
+func (e *Election) ObserveTerm(term uint64) bool {
+	e.Lock()
+	defer e.Unlock()
+	return e.observeTerm(term)
+}
+
+// observeTerm adopts a newer term and clears our vote and leader.
+// Note: The caller must hold the election lock.
+func (e *Election) observeTerm(term uint64) bool {
+	if term <= e.Term {
+		return false
+	}
+	e.Term = term
+	e.VotedFor = ""
+	e.Leader = ""
+	return true
+}
+
+// ObserveLeader records the active member for a term received through a health check.
+// Returns false if the term is older than our current term.
+// Note: An existing leader for the same term is kept as the conflict is resolved elsewhere.
+func (e *Election) ObserveLeader(term uint64, hostname string) bool {
+	e.Lock()
+	defer e.Unlock()
+	if term < e.Term {
+		return false
+	}
+	if e.observeTerm(term) || e.Leader == "" {
+		e.Leader = hostname
+	}
+	return true
+}
+
+// Grant decides whether we give our vote to a candidate for a particular term.
+// We only vote once per term and never for a term older than our own.
+func (e *Election) Grant(term uint64, candidate string) (bool, uint64) {
+	e.Lock()
+	defer e.Unlock()
+	if term < e.Term {
+		return false, e.Term
+	}
+	e.observeTerm(term)
+	if e.VotedFor != "" && e.VotedFor != candidate {
+		return false, e.Term
+	}
+	e.VotedFor = candidate
+	return true, e.Term
+}
+
+// WouldGrant decides whether we would give our vote to a candidate for a particular term
+// without adopting the term or recording a vote.
+func (e *Election) WouldGrant(term uint64, candidate string) (bool, uint64) {
+	e.Lock()
+	defer e.Unlock()
+	if term < e.Term || (term == e.Term && e.VotedFor != "" && e.VotedFor != candidate) {
+		return false, e.Term
+	}
+	return true, e.Term
+}
+
+// Assume makes the hostname the leader of the current term.
+// Note: A new term is started unless the hostname already leads the current one.
+func (e *Election) Assume(hostname string) uint64 {
+	e.Lock()
+	defer e.Unlock()
+	if e.Leader != hostname {
+		e.Term++
+		e.VotedFor = hostname
+		e.Leader = hostname
+	}
+	return e.Term
+}
+
+// StepDown clears our leadership of the current term.
+func (e *Election) StepDown(hostname string) {
+	e.Lock()
+	defer e.Unlock()
+	if e.Leader == hostname {
+		e.Leader = ""
+	}
+}
+
+// startTerm starts a new term voting for the candidate.
+func (e *Election) startTerm(candidate string) uint64 {
+	e.Lock()
+	defer e.Unlock()
+	e.Term++
+	e.VotedFor = candidate
+	e.Leader = ""
+	return e.Term
+}
+
+// Campaign requests a vote from every member in our cluster for the candidate.
+// Returns true when a quorum of the configured cluster has granted us their vote.
+// Note: A pre-vote is held first so a candidate that can't win never starts a term
+// that would make the active step down.
+func (e *Election) Campaign(candidate *Member) bool {
+	needed := quorumSize(DB.Config.NodeCount())
+	if votes := e.requestVotes(candidate, e.GetTerm()+1, true); votes < needed {
+		DB.Logging.Warn("Not starting an election as only " + strconv.Itoa(votes) + " of " +
+			strconv.Itoa(needed) + " required members would vote for us")
+		return false
+	}
+	term := e.startTerm(candidate.GetHostname())
+	DB.Logging.Info("Requesting votes for term " + strconv.FormatUint(term, 10) +
+		" (" + strconv.Itoa(needed) + " required)")
+	votes := e.requestVotes(candidate, term, false)
+	// Make sure a newer term didn't start while we were campaigning
+	if e.GetTerm() != term {
+		DB.Logging.Info("Election for term " + strconv.FormatUint(term, 10) + " was superseded by a newer term")
+		return false
+	}
+	if votes < needed {
+		DB.Logging.Warn("Lost election for term " + strconv.FormatUint(term, 10) + " with " +
+			strconv.Itoa(votes) + " of " + strconv.Itoa(needed) + " required votes")
+		return false
+	}
+	e.Lock()
+	e.Leader = candidate.GetHostname()
+	e.Unlock()
+	DB.Logging.Info("Won election for term " + strconv.FormatUint(term, 10) + " with " + strconv.Itoa(votes) + " votes")
+	return true
+}
+
+// requestVotes requests a vote for the candidate from every other member.
+// Returns the number of votes granted including our own.
+func (e *Election) requestVotes(candidate *Member, term uint64, preVote bool) int {
+	// We always vote for ourselves
+	votes := 1
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, member := range DB.MemberList.GetMembers() {
+		if member.GetHostname() == candidate.GetHostname() {
+			continue
+		}
+		wg.Add(1)
+		go func(member *Member) {
+			defer wg.Done()
+			if err := member.Connect(); err != nil {
+				DB.Logging.Debug("Election:Campaign() Unable to connect to " + member.GetHostname())
+				return
+			}
+			r, err := member.Send(client.SendVote, &rpc.VoteRequest{
+				Term:      term,
+				Candidate: candidate.GetHostname(),
+				Score:     int32(candidate.GetScore()),
+				PreVote:   preVote,
+			})
+			if err != nil {
+				DB.Logging.Debug("Election:Campaign() Vote request to " + member.GetHostname() + " failed: " + err.Error())
+				return
+			}
+			resp := r.(*rpc.VoteResponse)
+			// Someone knows of a newer term so our campaign is over
+			if e.ObserveTerm(resp.Term) {
+				return
+			}
+			if resp.Granted {
+				mu.Lock()
+				votes++
+				mu.Unlock()
+			}
+		}(member)
+	}
+	wg.Wait()
+	return votes
+}
+
+// quorumSize returns the number of votes required to win an election.
+// Note: A two node cluster can't elect a new active once its peer has failed
+// unless a monitoring node votes as a witness.
+func quorumSize(nodes int) int {
+	return nodes/2 + 1
+}
@@ -0,0 +1,145 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"testing"
+)
+
+func TestQuorumSize(t *testing.T) {
+	cases := map[int]int{1: 1, 2: 2, 3: 2, 4: 3, 5: 3}
+	for nodes, want := range cases {
+		if got := quorumSize(nodes); got != want {
+			t.Errorf("quorumSize(%d) = %d, want %d", nodes, got, want)
+		}
+	}
+}
+
+func TestElectionGrantOncePerTerm(t *testing.T) {
+	e := &Election{}
+	if granted, _ := e.Grant(1, "node1"); !granted {
+		t.Fatal("expected vote to be granted for a new term")
+	}
+	if granted, _ := e.Grant(1, "node2"); granted {
+		t.Fatal("expected a second vote in the same term to be refused")
+	}
+	if granted, _ := e.Grant(1, "node1"); !granted {
+		t.Fatal("expected a repeated request from the same candidate to be granted")
+	}
+	if granted, term := e.Grant(2, "node2"); !granted || term != 2 {
+		t.Fatal("expected vote to be granted for a newer term")
+	}
+}
+
+func TestElectionWouldGrant(t *testing.T) {
+	e := &Election{Term: 2, VotedFor: "node1"}
+	if granted, _ := e.WouldGrant(2, "node2"); granted {
+		t.Error("expected a pre-vote to be refused once we voted in the term")
+	}
+	if granted, _ := e.WouldGrant(3, "node2"); !granted {
+		t.Error("expected a pre-vote for a newer term to be granted")
+	}
+	// A pre-vote never changes our term or vote
+	if e.Term != 2 || e.VotedFor != "node1" {
+		t.Errorf("expected term 2 voted for node1, got term %d voted for %s", e.Term, e.VotedFor)
+	}
+}
+
+func TestElectionGrantRefusesOldTerm(t *testing.T) {
+	e := &Election{Term: 5}
+	granted, term := e.Grant(4, "node1")
+	if granted || term != 5 {
+		t.Fail()
+	}
+}
+
+func TestElectionObserveLeader(t *testing.T) {
+	e := &Election{}
+	if term := e.Assume("node1"); term != 1 {
+		t.Fatalf("expected term 1, got %d", term)
+	}
+	// Assuming again for the same leader must not start a new term
+	if term := e.Assume("node1"); term != 1 {
+		t.Fatalf("expected term to remain 1, got %d", term)
+	}
+	// A conflicting leader for the same term does not replace ours
+	if !e.ObserveLeader(1, "node2") || e.Leader != "node1" {
+		t.Fatal("expected existing leader to be kept for the same term")
+	}
+	// A newer term replaces our leader
+	if !e.ObserveLeader(2, "node2") || e.Leader != "node2" {
+		t.Fatal("expected leader of newer term to be recorded")
+	}
+	// An older term is rejected
+	if e.ObserveLeader(1, "node3") {
+		t.Fatal("expected older term to be rejected")
+	}
+}
+
+func TestVoteRefusalKeepsTerm(t *testing.T) {
+	setupTestPaths()
+	DB.Election = &Election{Term: 1}
+	// node1 is active so the vote is refused
+	s := &Server{}
+	for _, preVote := range []bool{true, false} {
+		resp, err := s.Vote(peerContext("node2", true), &rpc.VoteRequest{Term: 2, Candidate: "node2", PreVote: preVote})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Granted || resp.Term != 1 || DB.Election.GetTerm() != 1 {
+			t.Errorf("expected the vote to be refused without adopting term 2, got granted %t term %d", resp.Granted, DB.Election.GetTerm())
+		}
+	}
+}
+
+func TestPreVoteKeepsTerm(t *testing.T) {
+	setupTestPaths()
+	DB.Config.Pulse.LocalNode = "b"
+	DB.MemberList.GetMemberByHostname("node1").SetStatus(rpc.MemberStatus_UNAVAILABLE)
+	DB.Election = &Election{Term: 1}
+	s := &Server{}
+	resp, err := s.Vote(peerContext("node1", true), &rpc.VoteRequest{Term: 2, Candidate: "node1", PreVote: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Granted || DB.Election.GetTerm() != 1 || DB.Election.VotedFor != "" {
+		t.Errorf("expected the pre-vote to be granted without a vote or term change, got granted %t term %d", resp.Granted, DB.Election.GetTerm())
+	}
+}
+
+func TestVoteRefusedForUnelectableCandidate(t *testing.T) {
+	for _, node := range []config.Node{{Monitoring: true}, {Maintenance: true}} {
+		setupTestPaths()
+		DB.Config.Pulse.LocalNode = "b"
+		DB.Config.Nodes["a"].Monitoring = node.Monitoring
+		DB.Config.Nodes["a"].Maintenance = node.Maintenance
+		DB.MemberList.GetMemberByHostname("node1").SetStatus(rpc.MemberStatus_UNAVAILABLE)
+		DB.Election = &Election{Term: 1}
+		s := &Server{}
+		for _, preVote := range []bool{true, false} {
+			resp, err := s.Vote(peerContext("node1", true), &rpc.VoteRequest{Term: 2, Candidate: "node1", PreVote: preVote})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Granted || resp.Message == "" || DB.Election.GetTerm() != 1 {
+				t.Errorf("expected the vote to be refused for %+v, got %+v", node, resp)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"sync"
+	"time"
+)
+
+// The types of event we record.
+const (
+	EventStateChange = "state_change"
+	EventSplitBrain  = "split_brain"
+	EventConfigDrift = "config_drift"
+)
+
+// The number of events we keep in memory.
+const maxEvents = 500
+
+// Event defines a single entry in our event log.
+type Event struct {
+	Type     string
+	Hostname string
+	Message  string
+	Time     time.Time
+}
+
+// PluginEvents can optionally be implemented by general plugins to receive events.
+type PluginEvents interface {
+	OnEvent(event Event)
+}
+
+// EventLog defines our in memory log of cluster events.
+type EventLog struct {
+	events []Event
+	sync.Mutex
+}
+
+// Record adds an event to our log and informs our plugins.
+func (e *EventLog) Record(eventType string, hostname string, message string) {
+	event := Event{
+		Type:     eventType,
+		Hostname: hostname,
+		Message:  message,
+		Time:     time.Now(),
+	}
+	e.Lock()
+	e.events = append(e.events, event)
+	if len(e.events) > maxEvents {
+		e.events = e.events[len(e.events)-maxEvents:]
+	}
+	e.Unlock()
+	DB.Logging.Debug("EventLog:Record() " + eventType + " " + hostname + ": " + message)
+	// Inform our plugins
+	if DB.Plugins == nil {
+		return
+	}
+	for _, p := range DB.Plugins.GetGeneralPlugins() {
+		if plgin, ok := p.Plugin.(PluginEvents); ok {
+			go plgin.OnEvent(event)
+		}
+	}
+}
+
+// List returns a copy of our recorded events.
+func (e *EventLog) List() []Event {
+	e.Lock()
+	defer e.Unlock()
+	return append([]Event{}, e.events...)
+}
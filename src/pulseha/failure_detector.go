@@ -0,0 +1,274 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"math"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time to our failure detectors.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the clock used outside of tests.
+type systemClock struct{}
+
+// Now returns the current time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FailureDetector decides whether the active member has failed based on the health checks we receive.
+type FailureDetector interface {
+	// Heartbeat records the arrival of a health check.
+	Heartbeat()
+	// Reset restarts the time since the last health check without recording an arrival.
+	Reset()
+	// Suspect returns whether the active member is suspected to have failed.
+	Suspect() bool
+	// Failed returns whether the active member is considered to have failed.
+	Failed() bool
+}
+
+// FixedTimeout considers the active failed once no health check has arrived for a fixed time.
+type FixedTimeout struct {
+	// Time without a health check before the active is suspicious
+	SuspectAfter time.Duration
+	// Time without a health check before we fail over
+	FailAfter time.Duration
+	clock     Clock
+	last      time.Time
+	sync.Mutex
+}
+
+// NewFixedTimeout creates a fixed timeout failure detector.
+func NewFixedTimeout(suspectAfter time.Duration, failAfter time.Duration, clock Clock) *FixedTimeout {
+	return &FixedTimeout{
+		SuspectAfter: suspectAfter,
+		FailAfter:    failAfter,
+		clock:        clock,
+		last:         clock.Now(),
+	}
+}
+
+// Heartbeat records the arrival of a health check.
+func (f *FixedTimeout) Heartbeat() {
+	f.Reset()
+}
+
+// Reset restarts the time since the last health check.
+func (f *FixedTimeout) Reset() {
+	f.Lock()
+	defer f.Unlock()
+	f.last = f.clock.Now()
+}
+
+// elapsed returns the time since the last health check.
+func (f *FixedTimeout) elapsed() time.Duration {
+	f.Lock()
+	defer f.Unlock()
+	return f.clock.Now().Sub(f.last)
+}
+
+// Suspect returns whether the suspect timeout has passed.
+func (f *FixedTimeout) Suspect() bool {
+	return f.elapsed() >= f.SuspectAfter
+}
+
+// Failed returns whether the failover timeout has passed.
+func (f *FixedTimeout) Failed() bool {
+	return f.elapsed() >= f.FailAfter
+}
+
+// PhiAccrual calculates a suspicion level (phi) from the history of health check inter-arrival times.
+// A phi of 1 means a 10% chance that a health check would still arrive, 2 means 1%, 3 means 0.1% and so on.
+// Note: Based on "The φ Accrual Failure Detector" by Hayashibara et al.
+type PhiAccrual struct {
+	// The phi at which the active is suspicious
+	SuspectThreshold float64
+	// The phi at which we fail over
+	FailThreshold float64
+	// The smallest standard deviation used so a perfectly regular history isn't too sensitive
+	MinStdDeviation time.Duration
+	// The number of inter-arrival times kept
+	windowSize int
+	// The recorded inter-arrival times in milliseconds
+	intervals []float64
+	clock     Clock
+	last      time.Time
+	// Whether a health check has been received since we started
+	started bool
+	sync.Mutex
+}
+
+// NewPhiAccrual creates a phi accrual failure detector.
+// The history starts out with the expected health check interval.
+func NewPhiAccrual(suspectThreshold float64, failThreshold float64, windowSize int, expected time.Duration, clock Clock) *PhiAccrual {
+	if windowSize < 2 {
+		windowSize = 2
+	}
+	// Start with two intervals around the expected interval
+	// Note: This gives a standard deviation of a quarter of the expected interval.
+	mean := float64(expected.Milliseconds())
+	return &PhiAccrual{
+		SuspectThreshold: suspectThreshold,
+		FailThreshold:    failThreshold,
+		MinStdDeviation:  expected / 10,
+		windowSize:       windowSize,
+		intervals:        []float64{mean - mean/4, mean + mean/4},
+		clock:            clock,
+		last:             clock.Now(),
+	}
+}
+
+// Heartbeat records the arrival of a health check and the time since the previous one.
+func (p *PhiAccrual) Heartbeat() {
+	p.Lock()
+	defer p.Unlock()
+	now := p.clock.Now()
+	// The first health check only starts our history
+	if p.started {
+		p.intervals = append(p.intervals, float64(now.Sub(p.last))/float64(time.Millisecond))
+		if len(p.intervals) > p.windowSize {
+			p.intervals = p.intervals[len(p.intervals)-p.windowSize:]
+		}
+	}
+	p.started = true
+	p.last = now
+}
+
+// Reset restarts the time since the last health check.
+// Note: The next health check is not recorded as an arrival as the gap isn't representative.
+func (p *PhiAccrual) Reset() {
+	p.Lock()
+	defer p.Unlock()
+	p.last = p.clock.Now()
+	p.started = false
+}
+
+// Phi returns the current suspicion level.
+func (p *PhiAccrual) Phi() float64 {
+	p.Lock()
+	defer p.Unlock()
+	elapsed := float64(p.clock.Now().Sub(p.last)) / float64(time.Millisecond)
+	var mean, variance float64
+	for _, interval := range p.intervals {
+		mean += interval
+	}
+	mean /= float64(len(p.intervals))
+	for _, interval := range p.intervals {
+		variance += (interval - mean) * (interval - mean)
+	}
+	variance /= float64(len(p.intervals))
+	stdDeviation := math.Max(math.Sqrt(variance), float64(p.MinStdDeviation)/float64(time.Millisecond))
+	return phi(elapsed, mean, stdDeviation)
+}
+
+// Suspect returns whether phi has reached the suspect threshold.
+func (p *PhiAccrual) Suspect() bool {
+	return p.Phi() >= p.SuspectThreshold
+}
+
+// Failed returns whether phi has reached the failover threshold.
+func (p *PhiAccrual) Failed() bool {
+	return p.Phi() >= p.FailThreshold
+}
+
+// phi calculates the suspicion level for the time elapsed since the last arrival.
+// Note: Uses a logistic approximation of the cumulative normal distribution.
+func phi(elapsed float64, mean float64, stdDeviation float64) float64 {
+	y := (elapsed - mean) / stdDeviation
+	e := math.Exp(-y * (1.5976 + 0.070566*y*y))
+	if elapsed > mean {
+		return -math.Log10(e / (1.0 + e))
+	}
+	return -math.Log10(1.0 - 1.0/(1.0+e))
+}
+
+// newFailureDetector creates the failure detector configured for the local node.
+func newFailureDetector(c *config.Config, clock Clock) FailureDetector {
+	if c.GetFailureDetector() == config.DetectorPhiAccrual {
+		return NewPhiAccrual(
+			c.GetPhiSuspectThreshold(),
+			c.GetPhiFailoverThreshold(),
+			c.GetPhiWindowSize(),
+			time.Duration(c.Pulse.HealthCheckInterval)*time.Millisecond,
+			clock,
+		)
+	}
+	return NewFixedTimeout(
+		c.GetSuspectTimeout(),
+		time.Duration(c.Pulse.FailOverLimit)*time.Millisecond,
+		clock,
+	)
+}
+
+// Detector holds the failure detector used to monitor the health checks we receive.
+// The detector is replaced when its configuration changes.
+type Detector struct {
+	detector FailureDetector
+	// The configuration the detector was created with
+	settings config.Local
+	sync.Mutex
+}
+
+// get returns our failure detector, creating it if our configuration has changed.
+func (d *Detector) get() FailureDetector {
+	d.Lock()
+	defer d.Unlock()
+	settings := DB.Config.Pulse
+	if d.detector == nil || !sameDetectorSettings(d.settings, settings) {
+		d.detector = newFailureDetector(DB.Config, systemClock{})
+		d.settings = settings
+	}
+	return d.detector
+}
+
+// Heartbeat records the arrival of a health check.
+func (d *Detector) Heartbeat() {
+	d.get().Heartbeat()
+}
+
+// Reset restarts the time since the last health check.
+func (d *Detector) Reset() {
+	d.get().Reset()
+}
+
+// Suspect returns whether the active member is suspected to have failed.
+func (d *Detector) Suspect() bool {
+	return d.get().Suspect()
+}
+
+// Failed returns whether the active member is considered to have failed.
+func (d *Detector) Failed() bool {
+	return d.get().Failed()
+}
+
+// sameDetectorSettings determines whether two configurations create the same failure detector.
+func sameDetectorSettings(a config.Local, b config.Local) bool {
+	return a.FailureDetector == b.FailureDetector &&
+		a.SuspectTimeout == b.SuspectTimeout &&
+		a.PhiSuspectThreshold == b.PhiSuspectThreshold &&
+		a.PhiFailoverThreshold == b.PhiFailoverThreshold &&
+		a.PhiWindowSize == b.PhiWindowSize &&
+		a.HealthCheckInterval == b.HealthCheckInterval &&
+		a.FailOverLimit == b.FailOverLimit
+}
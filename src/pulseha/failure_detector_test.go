@@ -0,0 +1,138 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestFixedTimeout(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	d := NewFixedTimeout(2*time.Second, 5*time.Second, clock)
+	clock.Advance(time.Second)
+	if d.Suspect() || d.Failed() {
+		t.Fatal("expected no suspicion after one second")
+	}
+	clock.Advance(time.Second)
+	if !d.Suspect() || d.Failed() {
+		t.Fatal("expected suspicion but no failure after two seconds")
+	}
+	d.Heartbeat()
+	if d.Suspect() {
+		t.Fatal("expected a health check to clear suspicion")
+	}
+	clock.Advance(5 * time.Second)
+	if !d.Failed() {
+		t.Error("expected failure after five seconds")
+	}
+}
+
+func TestPhiAccrual(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	d := NewPhiAccrual(5, 8, 10, time.Second, clock)
+	// Health checks arrive every second
+	for i := 0; i < 20; i++ {
+		clock.Advance(time.Second)
+		d.Heartbeat()
+	}
+	clock.Advance(time.Second)
+	if d.Suspect() {
+		t.Fatalf("expected no suspicion for an on time health check, phi %f", d.Phi())
+	}
+	clock.Advance(500 * time.Millisecond)
+	if !d.Suspect() {
+		t.Fatalf("expected suspicion for a late health check, phi %f", d.Phi())
+	}
+	clock.Advance(time.Second)
+	if !d.Failed() {
+		t.Fatalf("expected failure for a missing health check, phi %f", d.Phi())
+	}
+	d.Heartbeat()
+	if d.Suspect() {
+		t.Errorf("expected a health check to clear suspicion, phi %f", d.Phi())
+	}
+}
+
+func TestPhiAccrualAdaptsToJitter(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	d := NewPhiAccrual(5, 8, 10, time.Second, clock)
+	// Health checks arrive irregularly
+	for i := 0; i < 20; i++ {
+		clock.Advance(time.Duration(500+(i%2)*1000) * time.Millisecond)
+		d.Heartbeat()
+	}
+	clock.Advance(1500 * time.Millisecond)
+	if d.Suspect() {
+		t.Errorf("expected no suspicion within the usual jitter, phi %f", d.Phi())
+	}
+}
+
+func TestPhiAccrualWindow(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	d := NewPhiAccrual(5, 8, 5, time.Second, clock)
+	for i := 0; i < 20; i++ {
+		clock.Advance(time.Second)
+		d.Heartbeat()
+	}
+	if len(d.intervals) != 5 {
+		t.Errorf("expected 5 intervals to be kept, got %d", len(d.intervals))
+	}
+}
+
+func TestNewFailureDetector(t *testing.T) {
+	c := &config.Config{Pulse: config.Local{HealthCheckInterval: 1000, FailOverLimit: 10000}}
+	if _, ok := newFailureDetector(c, &fakeClock{}).(*FixedTimeout); !ok {
+		t.Error("expected the fixed timeout detector by default")
+	}
+	c.Pulse.FailureDetector = config.DetectorPhiAccrual
+	if _, ok := newFailureDetector(c, &fakeClock{}).(*PhiAccrual); !ok {
+		t.Error("expected the phi accrual detector")
+	}
+}
+
+func TestSubSecondFailureDetection(t *testing.T) {
+	c := &config.Config{Pulse: config.Local{HealthCheckInterval: 100, FailOverLimit: 300}}
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	d := newFailureDetector(c, clock)
+	clock.Advance(149 * time.Millisecond)
+	if d.Suspect() {
+		t.Fatal("expected no suspicion before half the failover limit")
+	}
+	clock.Advance(150 * time.Millisecond)
+	if !d.Suspect() || d.Failed() {
+		t.Fatal("expected suspicion but no failure before the failover limit")
+	}
+	clock.Advance(time.Millisecond)
+	if !d.Failed() {
+		t.Error("expected failure once the 300ms failover limit has passed")
+	}
+}
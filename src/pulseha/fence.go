@@ -0,0 +1,96 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"errors"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/packages/fencing"
+	"github.com/syleron/pulseha/rpc"
+)
+
+// fenceDriver returns the driver used to fence a node.
+// Built in drivers are preferred over fencing plugins of the same name.
+func fenceDriver(cfg *config.Fencing) (fencing.Driver, error) {
+	if fencing.IsBuiltin(cfg.Driver) {
+		return fencing.New(cfg)
+	}
+	plgin := DB.Plugins.GetFencingPlugin(cfg.Driver)
+	if plgin == nil {
+		return nil, errors.New("no fencing driver or plugin named " + cfg.Driver)
+	}
+	return plgin.Plugin.(PluginFence), nil
+}
+
+// fenceMember isolates a failed member and blocks until fencing has been confirmed.
+// Members without a fencing definition are not fenced.
+func fenceMember(hostname string) error {
+	_, node, err := DB.Config.GetNodeByHostname(hostname)
+	if err != nil {
+		return err
+	}
+	if node.Fencing == nil {
+		DB.Logging.Debug("fenceMember() No fencing configured for " + hostname)
+		return nil
+	}
+	driver, err := fenceDriver(node.Fencing)
+	if err != nil {
+		return err
+	}
+	timeout := DB.Config.GetFenceTimeout()
+	DB.Logging.Info("Fencing " + hostname + " using " + driver.Name() + " (timeout " + timeout.String() + ")")
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := driver.Fence(ctx, hostname); err != nil {
+		return err
+	}
+	DB.Logging.Info("Successfully fenced " + hostname)
+	return nil
+}
+
+// fenceActiveMember fences our failed active member before we take over from it.
+// Returns false when the failover must not continue.
+// Note: The failed active has usually been marked suspicious by the time we fail over.
+func fenceActiveMember() bool {
+	var activeMember *Member
+	for _, member := range DB.MemberList.GetMembers() {
+		if member.GetHostname() == DB.Config.LocalNode().Hostname {
+			continue
+		}
+		status := member.GetStatus()
+		if status == rpc.MemberStatus_ACTIVE || status == rpc.MemberStatus_SUSPICIOUS {
+			activeMember = member
+			break
+		}
+	}
+	if activeMember == nil {
+		return true
+	}
+	if err := fenceMember(activeMember.GetHostname()); err != nil {
+		if !DB.Config.FenceContinueOnFailure() {
+			DB.Logging.Error("Failover aborted as " + activeMember.GetHostname() + " could not be fenced: " + err.Error())
+			return false
+		}
+		DB.Logging.Warn("Unable to fence " + activeMember.GetHostname() + ". Continuing with failover: " + err.Error())
+	}
+	if err := activeMember.SetStatus(rpc.MemberStatus_UNAVAILABLE); err != nil {
+		DB.Logging.Error("Failover aborted as " + activeMember.GetHostname() + " could not be marked unavailable: " + err.Error())
+		return false
+	}
+	return true
+}
@@ -48,6 +48,7 @@ func groupDelete(groupName string) error {
 	if groupExist(groupName) {
 		if !nodeAssignedToInterface(groupName) {
 			delete(DB.Config.Groups, groupName)
+			delete(DB.Config.GroupOwners, groupName)
 			return nil
 		}
 		return errors.New("group has network interface assignments. Please remove them and try again")
@@ -63,6 +64,7 @@ func groupClearLocal() {
 	DB.Config.Lock()
 	defer DB.Config.Unlock()
 	DB.Config.Groups = map[string][]string{}
+	DB.Config.GroupOwners = map[string]string{}
 }
 
 /**
@@ -128,13 +130,12 @@ func groupAssign(groupName, uid, iface string) error {
 		if exists, _ := nodeInterfaceGroupExists(uid, iface, groupName); !exists {
 			// Add the group
 			DB.Config.Nodes[uid].IPGroups[iface] = append(DB.Config.Nodes[uid].IPGroups[iface], groupName)
-			// make the group active
-			hostname, _ := DB.MemberList.GetActiveMember()
+			// make the group active if we should be holding it
 			localNode, err := DB.Config.GetLocalNode()
 			if err != nil {
 				return errors.New("unable to retrieve local node configuration")
 			}
-			if hostname == localNode.Hostname {
+			if uid == DB.Config.GetLocalNodeUUID() && groupOwner(groupName) == localNode.Hostname {
 				makeGroupActive(iface, groupName)
 			}
 		} else {
@@ -155,9 +156,15 @@ func groupUnassign(groupName, uid, iface string) error {
 	if exists {
 		if exists, i := nodeInterfaceGroupExists(uid, iface, groupName); exists {
 			// make the group passive before removing it
-			makeGroupPassive(iface, groupName)
+			if uid == DB.Config.GetLocalNodeUUID() {
+				makeGroupPassive(iface, groupName)
+			}
 			// Remove it
 			DB.Config.Nodes[uid].IPGroups[iface] = append(DB.Config.Nodes[uid].IPGroups[iface][:i], DB.Config.Nodes[uid].IPGroups[iface][i+1:]...)
+			// A group can no longer be placed on a node it isn't assigned to
+			if !nodeHasGroup(uid, groupName) && DB.Config.GroupOwners[groupName] == DB.Config.Nodes[uid].Hostname {
+				delete(DB.Config.GroupOwners, groupName)
+			}
 		} else {
 			DB.Logging.Warn(groupName + " does not exist in node " + uid + ".. skipping.")
 		}
@@ -247,7 +254,9 @@ func makeGroupActive(iface string, groupName string) {
 	DB.Logging.Debug("Groups:makeGroupActive() Adding floating IPs from " + iface + " defined in group " + groupName)
 	if err := BringUpIPs(iface, DB.Config.Groups[groupName]); err != nil {
 		DB.Logging.Error(err.Error())
+		return
 	}
+	DB.Placement.setUp(groupName, iface)
 }
 
 /**
@@ -258,4 +267,5 @@ func makeGroupPassive(iface string, groupName string) {
 	if err := BringDownIPs(iface, DB.Config.Groups[groupName]); err != nil {
 		DB.Logging.Debug(err.Error())
 	}
+	DB.Placement.setDown(groupName)
 }
@@ -1,6 +1,7 @@
 package pulseha
 
 import (
+	"context"
 	log "github.com/sirupsen/logrus"
 	"sync"
 )
@@ -13,7 +14,7 @@ type HealthChecks struct {
 }
 
 // ProcessHCs send all loaded health checks to calculate a score
-func (hcs *HealthChecks) ProcessHCs() bool {
+func (hcs *HealthChecks) ProcessHCs(ctx context.Context) bool {
 	// Check to see if we have booted up before we start checking the health checks
 	if DB.StartDelay || !DB.Config.ClusterCheck() {
 		if !DB.Config.ClusterCheck() {
@@ -19,14 +19,14 @@
 package pulseha
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	log "github.com/sirupsen/logrus"
 	"github.com/syleron/pulseha/packages/client"
-	"github.com/syleron/pulseha/packages/utils"
 	"github.com/syleron/pulseha/rpc"
 	"google.golang.org/grpc/connectivity"
-	"math"
+	"strings"
 	"sync"
 	"time"
 )
@@ -45,6 +45,12 @@ type Member struct {
 	HCBusy bool
 	// Used to determine which node to fail over to
 	Score int
+	// When the member became active
+	ActiveSince time.Time
+	// The heartbeat paths to the member
+	Paths []*Path
+	// The last config hash reported by the member
+	ConfigHash string
 	// The client for the member that is used to send GRPC calls
 	*client.Client
 	// The mutex to lock the member object
@@ -113,6 +119,34 @@ func (m *Member) GetScore() int {
 	return m.Score
 }
 
+// SetActiveSince updates when this member became active.
+func (m *Member) SetActiveSince(since time.Time) {
+	m.Lock()
+	defer m.Unlock()
+	m.ActiveSince = since
+}
+
+// GetActiveSince returns when this member became active.
+func (m *Member) GetActiveSince() time.Time {
+	m.Lock()
+	defer m.Unlock()
+	return m.ActiveSince
+}
+
+// SetConfigHash records the config hash reported by the member.
+func (m *Member) SetConfigHash(hash string) {
+	m.Lock()
+	defer m.Unlock()
+	m.ConfigHash = hash
+}
+
+// GetConfigHash returns the last config hash reported by the member.
+func (m *Member) GetConfigHash() string {
+	m.Lock()
+	defer m.Unlock()
+	return m.ConfigHash
+}
+
 // SetLastHCResponse updates the last time this member recieved a health check.
 func (m *Member) SetLastHCResponse(time time.Time) {
 	m.Lock()
@@ -149,14 +183,42 @@ func (m *Member) GetStatus() rpc.MemberStatus_Status {
 	return m.Status
 }
 
-// SetStatus defines the status for a particular member
-func (m *Member) SetStatus(status rpc.MemberStatus_Status) {
-	DB.Logging.Debug("Member:setStatus() " + m.GetHostname() + " status set to " + status.String() + " called by " + MyCaller())
+// SetStatus moves a particular member to a new status.
+// Illegal transitions are rejected and logged. See memberTransitions.
+func (m *Member) SetStatus(status rpc.MemberStatus_Status) error {
+	return m.setStatus(status, true)
+}
+
+// ApplyStatus sets the status of a member as reported by our peers.
+// Note: The transition is not validated as our peers may have seen states we missed.
+func (m *Member) ApplyStatus(status rpc.MemberStatus_Status) {
+	m.setStatus(status, false)
+}
+
+// setStatus moves a member to a new status and runs our transition hooks.
+func (m *Member) setStatus(status rpc.MemberStatus_Status, validate bool) error {
 	m.Lock()
-	defer m.Unlock()
+	from := m.Status
+	if from == status {
+		m.Unlock()
+		return nil
+	}
+	if validate && !ValidTransition(from, status) {
+		m.Unlock()
+		DB.Logging.Warn("Rejected illegal transition of " + m.GetHostname() + " from " + from.String() + " to " + status.String() + " requested by " + MyCaller())
+		return errors.New("illegal transition from " + from.String() + " to " + status.String())
+	}
 	m.Status = status
-	// Inform our plugin(s) of state change
-	InformMLSChange()
+	m.Unlock()
+	DB.Logging.Debug("Member:setStatus() " + m.GetHostname() + " status set to " + status.String() + " called by " + MyCaller())
+	// Run our transition hooks
+	DB.StateMachine.Fire(Transition{
+		Hostname: m.GetHostname(),
+		From:     from,
+		To:       status,
+		Time:     time.Now(),
+	})
+	return nil
 }
 
 // SetClient defines our client object for a member.
@@ -168,15 +230,20 @@ func (m *Member) SetClient(client *client.Client) {
 
 // Connect establish a connection with a particular member.
 // Note: Member hostname is required for TLS reasons.
+// Note: The member lock is held as our heartbeat paths share the member client.
 func (m *Member) Connect() error {
+	m.Lock()
+	defer m.Unlock()
 	if (m.Connection == nil) || (m.Connection != nil && m.Connection.GetState() == connectivity.Shutdown) {
 		_, nodeDetails, _ := nodeGetByHostname(m.Hostname)
-		DB.Logging.Debug("Member:Connect() Attempting to connect with node " + m.Hostname + " " + nodeDetails.IP + ":" + nodeDetails.Port)
+		// The member's certificate must be issued to its hostname
+		m.Client.ServerName = m.Hostname
 		err := m.Client.Connect(nodeDetails.IP, nodeDetails.Port, true)
 		if err != nil {
 			log.Error("Member:Connect() " + err.Error())
 			return err
 		}
+		log.Debug("Member:Connect() Connected with node " + m.Hostname + " " + nodeDetails.IP + ":" + nodeDetails.Port)
 	}
 	return nil
 }
@@ -184,18 +251,35 @@ func (m *Member) Connect() error {
 // Close terminates the client connection
 func (m *Member) Close() {
 	DB.Logging.Debug("Member:Close() Connection closed")
+	m.Lock()
+	defer m.Unlock()
 	m.Client.Close()
+	for _, path := range m.Paths {
+		if path.client != m.Client {
+			path.client.Close()
+		}
+	}
+}
+
+// healthCheckTimeout returns how long we wait for a health check response.
+// Note: A slow path must give up in time for the next path to be tried before the member fails over.
+func healthCheckTimeout() time.Duration {
+	return time.Duration(DB.Config.Pulse.HealthCheckInterval) * time.Millisecond
 }
 
 // SendHealthCheck sends GRPC health check to current member
 // Type: Active node function
 // Note: Consider sending this periodically instead of the base health check
 func (m *Member) SendHealthCheck(data *rpc.HealthCheckRequest) (interface{}, error) {
-	if m.Connection == nil {
-		return rpc.HealthCheckResponse{}, errors.New("unable to send health check as member connection has not been initiated")
-	}
 	startTime := time.Now()
-	r, err := m.Send(client.SendHealthCheck, data)
+	var r interface{}
+	var err error
+	if DB.Transport.Running() {
+		r, err = DB.Transport.Exchange(m.GetHostname(), data)
+	} else {
+		// Note: The member is only unavailable when every heartbeat path is down
+		r, err = m.sendHealthCheckOverPaths(data)
+	}
 	// This is a record for the active appliance to know when it was last sent/received!
 	m.SetLastHCResponse(time.Now())
 	elapsed := fmt.Sprint(time.Since(startTime).Round(time.Millisecond))
@@ -217,14 +301,31 @@ func (m *Member) RoutineHC(data *rpc.HealthCheckRequest) {
 	if response != nil && response.(*rpc.HealthCheckResponse) != nil {
 		// Update our score
 		m.SetScore(int(response.(*rpc.HealthCheckResponse).Score))
+		// Pull the config of the member if it is newer or re-sync it if it has drifted
+		m.SetConfigHash(response.(*rpc.HealthCheckResponse).ConfigHash)
+		DB.Replication.Reconcile(m.GetHostname(), versionFromRPC(response.(*rpc.HealthCheckResponse).ConfigVersion), response.(*rpc.HealthCheckResponse).ConfigHash)
+		// A member knows of a newer election term so we are no longer the rightful active
+		if DB.Election.ObserveTerm(response.(*rpc.HealthCheckResponse).Term) {
+			DB.Logging.Warn("Member " + m.GetHostname() + " reported a newer election term. Stepping down..")
+			if localMember, err := DB.MemberList.GetLocalMember(); err == nil {
+				if err := localMember.MakePassive(context.Background()); err != nil {
+					DB.Logging.Error(err.Error())
+				}
+			}
+		}
 	}
 	m.SetHCBusy(false)
 }
 
 // MakeActive promotes a particular member to become active.
-func (m *Member) MakeActive() error {
+func (m *Member) MakeActive(ctx context.Context) error {
 	DB.Logging.Debug("Member:makeActive() Making " + m.GetHostname() + " active")
 
+	// Monitoring nodes and nodes in maintenance can never become active
+	if !nodeIsElectable(m.GetHostname()) {
+		return errors.New("unable to make " + m.GetHostname() + " active as it is a monitoring node or in maintenance")
+	}
+
 	// Inform our plugins
 	for _, p := range DB.Plugins.GetGeneralPlugins() {
 		go p.Plugin.(PluginGen).OnMemberFailover(*m)
@@ -242,18 +343,31 @@ func (m *Member) MakeActive() error {
 		m.SetLatency("")
 		m.SetLastHCResponse(time.Time{})
 		// Set our state
-		m.SetStatus(rpc.MemberStatus_ACTIVE)
+		// Note: Nothing is brought up when we aren't allowed to become active
+		wasActive := m.GetStatus() == rpc.MemberStatus_ACTIVE
+		if err := m.SetStatus(rpc.MemberStatus_ACTIVE); err != nil {
+			return err
+		}
+		if !wasActive {
+			m.SetActiveSince(time.Now())
+		}
+		// Lead the current election term
+		DB.Election.Assume(m.GetHostname())
+		// We no longer need to monitor for health checks
+		DB.Supervisor.Stop(ctx, TaskMonitorReceivedHCs)
 		// Bring up our addresses if we have any
 		MakeLocalActive()
 		// Start monitoring our member list
 		DB.Logging.Debug("Member:PromoteMember() Starting client connections monitor")
-		go utils.Scheduler(
+		DB.Supervisor.Start(
+			TaskMonitorClientConns,
 			DB.MemberList.MonitorClientConns,
 			time.Duration(DB.Config.Pulse.HealthCheckInterval)*time.Millisecond,
 		)
 		// Start performing health checks
 		DB.Logging.Debug("Member:PromoteMember() Starting health check handler")
-		go utils.Scheduler(
+		DB.Supervisor.Start(
+			TaskHealthCheckHandler,
 			DB.MemberList.AddHealthCheckHandler,
 			time.Duration(DB.Config.Pulse.HealthCheckInterval)*time.Millisecond,
 		)
@@ -266,7 +380,7 @@ func (m *Member) MakeActive() error {
 		return err
 	}
 	// Inform member to become active.
-	_, err = m.Send(
+	r, err := m.Send(
 		client.SendPromote,
 		&rpc.PromoteRequest{
 			Member: m.GetHostname(),
@@ -276,12 +390,15 @@ func (m *Member) MakeActive() error {
 		log.Errorf("Error making %s active. Error: %s", m.GetHostname(), err.Error())
 		return err
 	}
+	if resp := r.(*rpc.PromoteResponse); !resp.Success {
+		return errors.New(m.GetHostname() + " refused to become active: " + resp.Message)
+	}
 
 	return nil
 }
 
 // MakePassive demotes a particular member to become passive.
-func (m *Member) MakePassive() error {
+func (m *Member) MakePassive(ctx context.Context) error {
 	DB.Logging.Debug("Member:makePassive() Making " + m.GetHostname() + " passive")
 
 	// Get our local node object
@@ -294,18 +411,24 @@ func (m *Member) MakePassive() error {
 	if m.GetHostname() == localNode.Hostname {
 		// do this regardless to make sure we dont have any groups up
 		MakeLocalPassive()
+		// We no longer lead the current term
+		DB.Election.StepDown(m.GetHostname())
+		// Stop the tasks only the active should be running
+		DB.Supervisor.Stop(ctx, TaskMonitorClientConns)
+		DB.Supervisor.Stop(ctx, TaskHealthCheckHandler)
 		// Update member variables
 		m.SetLastHCResponse(time.Now())
-		// check if we are already passive before starting a new scheduler
-		if m.GetStatus() != rpc.MemberStatus_PASSIVE {
-			m.SetStatus(rpc.MemberStatus_PASSIVE)
-			// Start the scheduler
-			DB.Logging.Debug("Member:makePassive() Starting the monitor received health checks scheduler " + m.GetHostname())
-			go utils.Scheduler(
-				m.MonitorReceivedHCs,
-				time.Duration(DB.Config.Pulse.FailOverInterval)*time.Millisecond,
-			)
-		}
+		m.SetActiveSince(time.Time{})
+		DB.Detector.Reset()
+		m.SetStatus(standbyStatus(m.GetHostname()))
+		// Start the scheduler
+		// Note: The supervisor makes sure only one monitor is ever running
+		DB.Logging.Debug("Member:makePassive() Starting the monitor received health checks scheduler " + m.GetHostname())
+		DB.Supervisor.Start(
+			TaskMonitorReceivedHCs,
+			m.MonitorReceivedHCs,
+			time.Duration(DB.Config.Pulse.FailOverInterval)*time.Millisecond,
+		)
 		return nil
 	}
 
@@ -315,7 +438,7 @@ func (m *Member) MakePassive() error {
 		return err
 	}
 	// Inform member to become passive.
-	_, err = m.Send(
+	r, err := m.Send(
 		client.SendMakePassive,
 		&rpc.MakePassiveRequest{
 			Member: m.GetHostname(),
@@ -325,6 +448,9 @@ func (m *Member) MakePassive() error {
 		log.Errorf("Error making %s passive. Error: %s", m.GetHostname(), err.Error())
 		return err
 	}
+	if resp := r.(*rpc.MakePassiveResponse); !resp.Success {
+		return errors.New(m.GetHostname() + " refused to become passive: " + resp.Message)
+	}
 
 	return nil
 }
@@ -366,7 +492,7 @@ func (m *Member) BringUpIPs(ips []string, group string) bool {
 }
 
 // MonitorReceivedHCs monitor the last time we received a health check and or fail over.
-func (m *Member) MonitorReceivedHCs() bool {
+func (m *Member) MonitorReceivedHCs(ctx context.Context) bool {
 	// Clear routine
 	if !DB.Config.ClusterCheck() {
 		log.Debug("MonitorReceivedHCs() routine cleared")
@@ -382,10 +508,8 @@ func (m *Member) MonitorReceivedHCs() bool {
 		DB.Logging.Debug("Member:monitorReceivedHCs() Health check received monitor disabled as we are now active.")
 		return true
 	}
-	// calculate elapsed time
-	elapsed := math.Floor(float64(time.Since(m.GetLastHCResponse()).Seconds()))
 	// determine if we might need to failover
-	if int(elapsed) > 0 && int(elapsed)%4 == 0 {
+	if DB.Detector.Suspect() {
 		_, member := DB.MemberList.GetActiveMember()
 		if member != nil {
 			member.SetStatus(rpc.MemberStatus_SUSPICIOUS)
@@ -393,23 +517,47 @@ func (m *Member) MonitorReceivedHCs() bool {
 		DB.Logging.Debug("Member:MonitorReceivedHCs() No health checks are being made.. Perhaps a failover is required?")
 	}
 	// has our threshold been met? Failover?
-	var foLimit int
+	// Note: We never fail over on our first check after starting up.
+	failed := DB.Detector.Failed()
 	if DB.StartDelay && DB.StartInterval < 1 {
-		foLimit = DB.Config.Pulse.FailOverLimit * 2
+		failed = false
 		DB.StartInterval++
 	} else {
-		foLimit = DB.Config.Pulse.FailOverLimit
 		DB.StartDelay = false
 	}
-	if int(elapsed) >= (foLimit / 1000) {
+	// With mesh health checks only fail over if no other member can reach the active either
+	if failed && meshEnabled() {
+		if active, peers := activeReachedByPeers(); len(peers) > 0 {
+			DB.Logging.Warn("No health checks received but " + active + " is still reachable by " +
+				strings.Join(peers, ", ") + ". Not failing over")
+			return false
+		}
+	}
+	if failed {
 		DB.Logging.Debug("Member:monitorReceivedHCs() Performing Fail-over..")
+		// We can no longer be sure we are part of the cluster so release any groups placed on us.
+		// Note: The active takes over these groups once it considers us unavailable.
+		releaseHeldGroups()
 		// Nothing has worked.. assume the master has failed. Fail over.
 		member, err := DB.MemberList.GetNextActiveMember()
 		// no new active appliance was found
 		if err != nil {
+			// Monitoring nodes and nodes in maintenance never take over
+			if !nodeIsElectable(m.GetHostname()) {
+				DB.Logging.Warn("No member is available to become active. The local node is a monitoring node or in maintenance")
+				return false
+			}
+			// Make sure the cluster agrees before we make ourselves active
+			if !DB.Election.Campaign(m) {
+				return false
+			}
+			// Make sure the old active can no longer hold our floating IPs
+			if !fenceActiveMember() {
+				return false
+			}
 			DB.Logging.Warn("unable to find new active member.. we are now the active")
 			// make ourselves active as no new active can be found apparently
-			m.MakeActive()
+			m.MakeActive(ctx)
 			return true
 		}
 		// If we are not the new member just return
@@ -421,6 +569,17 @@ func (m *Member) MonitorReceivedHCs() bool {
 		if member.GetHostname() != localNode.Hostname {
 			DB.Logging.Info("Waiting on " + member.GetHostname() + " to become active")
 			m.SetLastHCResponse(time.Now())
+			DB.Detector.Reset()
+			return false
+		}
+		// Make sure a quorum of the cluster agrees before we go active.
+		// Note: We keep monitoring so another election is held next interval if we lost.
+		if !DB.Election.Campaign(member) {
+			return false
+		}
+		// Fence the old active before we take over its floating IPs.
+		// Note: The old active is marked unavailable once fenced.
+		if !fenceActiveMember() {
 			return false
 		}
 		// get our current active member
@@ -430,7 +589,7 @@ func (m *Member) MonitorReceivedHCs() bool {
 			activeMember.SetStatus(rpc.MemberStatus_UNAVAILABLE)
 		}
 		// lets go active
-		member.MakeActive()
+		member.MakeActive(ctx)
 		// Set the FO priority
 		member.SetLastHCResponse(time.Time{})
 		DB.Logging.Info("Local node is now active")
@@ -17,13 +17,15 @@
 package pulseha
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/packages/config"
 	"github.com/syleron/pulseha/packages/utils"
 	"github.com/syleron/pulseha/rpc"
 	"google.golang.org/grpc/connectivity"
+	"strings"
 	"sync"
 	"time"
 )
@@ -55,7 +57,7 @@ func (m *MemberList) AddMember(hostname string, client *client.Client) {
 	if !m.MemberExists(hostname) {
 		DB.Logging.Debug("MemberList:MemberAdd() " + hostname + " added to memberlist")
 		m.Lock()
-		newMember := &Member{}
+		newMember := &Member{Status: rpc.MemberStatus_UNCONFIGURED}
 		newMember.SetHostname(hostname)
 		newMember.SetStatus(rpc.MemberStatus_UNAVAILABLE)
 		newMember.SetClient(client)
@@ -94,6 +96,16 @@ func (m *MemberList) GetMemberByHostname(hostname string) *Member {
 	return nil
 }
 
+// GetMembers returns a copy of our member slice.
+// Note: Used when members need to be contacted without holding the member list lock.
+func (m *MemberList) GetMembers() []*Member {
+	m.Lock()
+	defer m.Unlock()
+	members := make([]*Member, len(m.Members))
+	copy(members, m.Members)
+	return members
+}
+
 // MemberExists check by hostname if member exists in our member list.
 func (m *MemberList) MemberExists(hostname string) bool {
 	m.Lock()
@@ -156,25 +168,46 @@ func (m *MemberList) Setup() {
 		hcs := HealthChecks{}
 		// TODO: Better way of doing this
 		hcs.Plugins = DB.Plugins.GetHealthCheckPlugins()
-		go utils.Scheduler(
+		DB.Supervisor.Start(
+			TaskProcessHealthChecks,
 			hcs.ProcessHCs,
 			time.Duration(5)*time.Second,
 		)
+		// Heartbeat every other member when mesh health checks are enabled
+		DB.Supervisor.Start(
+			TaskMeshHeartbeats,
+			m.SendMeshHeartbeats,
+			time.Duration(DB.Config.Pulse.HealthCheckInterval)*time.Millisecond,
+		)
+		// Renew our certificate before it expires
+		DB.Supervisor.Start(
+			TaskRenewCertificate,
+			renewCertificate,
+			time.Hour,
+		)
+		// Reload our certificates when they are replaced on disk
+		DB.Supervisor.Start(
+			TaskReloadCertificates,
+			reloadCertificates,
+			5*time.Second,
+		)
 		//fmt.Println(">>>>> ", <-hcs.ScoreChan)
 		// Are we the only member in the cluster?
-		if DB.Config.NodeCount() == 1 {
+		if DB.Config.NodeCount() == 1 && localNode.Electable() {
 			// Disable start up delay
 			DB.StartDelay = false
 			// We are the only member in the cluster so
 			// we are assume that we are now the active appliance.
-			m.PromoteMember(localNode.Hostname)
+			m.PromoteMember(context.Background(), localNode.Hostname)
 		} else {
 			// come up passive and monitoring health checks
 			localMember := m.GetMemberByHostname(localNode.Hostname)
 			localMember.SetLastHCResponse(time.Now())
-			localMember.SetStatus(rpc.MemberStatus_PASSIVE)
+			DB.Detector.Reset()
+			localMember.SetStatus(standbyStatus(localNode.Hostname))
 			DB.Logging.Debug("MemberList:Setup() starting the monitor received health checks scheduler")
-			go utils.Scheduler(
+			DB.Supervisor.Start(
+				TaskMonitorReceivedHCs,
 				localMember.MonitorReceivedHCs,
 				time.Duration(DB.Config.Pulse.FailOverInterval)*time.Millisecond,
 			)
@@ -200,6 +233,17 @@ func (m *MemberList) Reload() {
 	m.LoadMembers()
 }
 
+// RefreshStandbyStatus moves members that are not active into their configured standby status.
+// Note: Used when a node has been made or is no longer a monitoring node or in maintenance.
+func (m *MemberList) RefreshStandbyStatus() {
+	for _, member := range m.GetMembers() {
+		status := member.GetStatus()
+		if status == rpc.MemberStatus_PASSIVE || status == rpc.MemberStatus_MONITORING || status == rpc.MemberStatus_MAINTENANCE {
+			member.SetStatus(standbyStatus(member.GetHostname()))
+		}
+	}
+}
+
 // MemberGetStatus returns the status for a member by hostname.
 func (m *MemberList) MemberGetStatus(hostname string) (rpc.MemberStatus_Status, error) {
 	m.Lock()
@@ -224,65 +268,42 @@ func (m *MemberList) GetActiveMember() (string, *Member) {
 }
 
 // PromoteMember promotes a member as active within our member list.
-func (m *MemberList) PromoteMember(hostname string) error {
+// Note: Used when the member has already been chosen so its health score is not compared
+// and its addresses are not pinged.
+func (m *MemberList) PromoteMember(ctx context.Context, hostname string) error {
 	DB.Logging.Debug("MemberList:PromoteMember() MemberList promoting " + hostname + " as active member..")
-	// Inform everyone in the cluster that a specific node is now the new active
-	// Demote if old active is no longer active. promote if the passive is the new active.
-	// get host is it active?
+	_, err := m.Switchover(ctx, hostname, true, false)
+	return err
+}
 
-	// Make sure the hostname member exists
+// Switchover moves the active role to a particular member.
+// The steps taken are returned so they can be reported back.
+// Note: Unless forced the member must not have a lower health score than the current active.
+// When ping is set the addresses of its groups must respond once it is active.
+func (m *MemberList) Switchover(ctx context.Context, hostname string, force bool, ping bool) ([]*rpc.SwitchoverStep, error) {
 	newActive := m.GetMemberByHostname(hostname)
-
-	// Make sure we have a hostname
 	if newActive == nil {
-		DB.Logging.Warn("Unknown hostname " + hostname + " give in call to promoteMember")
-		return errors.New("the specified host does not exist in the configured cluster")
-	}
-
-	// if unavailable check it works or do nothing?
-	switch newActive.GetStatus() {
-	case rpc.MemberStatus_UNAVAILABLE:
-		// When we are attempting to promote a node who is unavailable
-		// If we are the only node and just configured we will be unavailable
-		if DB.Config.NodeCount() > 1 {
-			DB.Logging.Warn("Unable to promote member " + newActive.GetHostname() + " because it is unavailable")
-			return errors.New("unable to promote member as it is unavailable")
-		}
-	case rpc.MemberStatus_ACTIVE:
-		// When we are attempting to promote the active appliance
-		DB.Logging.Warn("Unable to promote member " + newActive.GetHostname() + " as it is active")
-		return errors.New("unable to promote member as it is already active")
+		DB.Logging.Warn("Unknown hostname " + hostname + " give in call to Switchover")
+		return nil, errors.New("the specified host does not exist in the configured cluster")
 	}
-
-	// get the current active member
 	_, activeMember := m.GetActiveMember()
-
-	// If we do have an active member, make it passive
-	if activeMember != nil {
-		// Make the current Active appliance passive
-		if err := activeMember.MakePassive(); err != nil {
-			DB.Logging.Warn("Failed to make " + activeMember.GetHostname() + " passive, continuing")
-		}
-		// TODO: Note: Do we need this?
-		// Update our local value for the active member
-		activeMember.SetStatus(rpc.MemberStatus_PASSIVE)
-	}
-
-	// make the the new node active
-	if err := newActive.MakeActive(); err != nil {
-		DB.Logging.Warn("Failed to promote " + newActive.GetHostname() + " to active. Falling back to " + activeMember.GetHostname())
-		// Somethings gone wrong.. attempt to make the previous active - active again.
-		if err := activeMember.MakeActive(); err != nil {
-			DB.Logging.Error("Failed to make reinstate the active node. Something is really wrong")
-		}
-		// Note: we don't need to update the active status as we should receive an updated memberlist from the active
-	}
-	return nil
+	switchover := &Switchover{
+		Target:   newActive,
+		Previous: activeMember,
+		Force:    force,
+		Ping:     ping,
+	}
+	if err := switchover.Run(ctx); err != nil {
+		DB.Logging.Warn("Failed to promote " + hostname + " to active: " + err.Error())
+		return switchover.Steps, err
+	}
+	DB.Logging.Info(hostname + " has been promoted to active")
+	return switchover.Steps, nil
 }
 
 // MonitorClientConns ensures the state for each node is correct based on health check responses.
 // Type: Active node scheduled function
-func (m *MemberList) MonitorClientConns() bool {
+func (m *MemberList) MonitorClientConns(ctx context.Context) bool {
 	// Clear routine
 	if !DB.Config.ClusterCheck() {
 		log.Debug("MonitorClientConns() routine cleared")
@@ -310,17 +331,19 @@ func (m *MemberList) MonitorClientConns() bool {
 		switch member.Connection.GetState() {
 		case connectivity.Idle:
 		case connectivity.Ready:
-			member.SetStatus(rpc.MemberStatus_PASSIVE)
+			member.SetStatus(standbyStatus(member.GetHostname()))
 		default:
 			member.SetStatus(rpc.MemberStatus_UNAVAILABLE)
 		}
 	}
+	// Take over the groups of any member that is no longer available
+	reconcileLocalGroups(false)
 	return false
 }
 
 // AddHealthCheckHandler sends GRPC health check messages to our member cluster.
 // Type: Active node scheduled function
-func (m *MemberList) AddHealthCheckHandler() bool {
+func (m *MemberList) AddHealthCheckHandler(ctx context.Context) bool {
 	// Clear routine
 	if !DB.Config.ClusterCheck() {
 		log.Debug("AddHealthCheckHandler() routine cleared")
@@ -336,17 +359,13 @@ func (m *MemberList) AddHealthCheckHandler() bool {
 		DB.Logging.Debug("MemberList:addHealthCheckHandler() Health check handler has stopped as it seems we are no longer active")
 		return true
 	}
-	//make sure we are still the highest scoring member
-	highScorer, err := m.GetHighestScoreMember()
-
+	// make sure we are still the member that should be active
+	preferred, err := m.GetPreferredMember()
 	if err != nil {
-		// TODO: better handling
-		panic(err)
-	}
-
-	if highScorer != localMember {
-		log.Debug("A different node has a higher score. Promoting...")
-		if err := m.PromoteMember(highScorer.Hostname); err != nil {
+		DB.Logging.Warn("MemberList:addHealthCheckHandler() " + err.Error())
+	} else if preferred != localMember {
+		DB.Logging.Info(preferred.GetHostname() + " outranks the local node. Promoting...")
+		if err := m.PromoteMember(ctx, preferred.GetHostname()); err != nil {
 			log.Debug(err)
 		}
 		return true
@@ -355,15 +374,26 @@ func (m *MemberList) AddHealthCheckHandler() bool {
 		if member.GetHostname() == localMember.Hostname {
 			continue
 		}
-		if !member.GetHCBusy() && member.GetStatus() == rpc.MemberStatus_PASSIVE {
-			memberlist := new(rpc.HealthCheckRequest)
+		status := member.GetStatus()
+		if !member.GetHCBusy() && (status == rpc.MemberStatus_PASSIVE || status == rpc.MemberStatus_MONITORING || status == rpc.MemberStatus_MAINTENANCE) {
+			memberlist := &rpc.HealthCheckRequest{
+				ActiveTime:    localMember.GetActiveSince().Format(time.RFC3339Nano),
+				Term:          DB.Election.GetTerm(),
+				Hostname:      localMember.GetHostname(),
+				ConfigVersion: versionToRPC(DB.Config.Version),
+				ConfigHash:    DB.Config.Hash(),
+			}
 			for _, member := range m.Members {
 				newMember := &rpc.MemberlistMember{
 					Hostname:     member.GetHostname(),
 					Status:       member.GetStatus(),
 					Latency:      member.GetLatency(),
-					LastReceived: member.GetLastHCResponse().Format(time.RFC1123),
+					LastReceived: member.GetLastHCResponse().Format(time.RFC3339Nano),
 					Score:        int32(member.Score),
+					ConfigHash:   member.GetConfigHash(),
+				}
+				if member.GetHostname() == localMember.GetHostname() {
+					newMember.ConfigHash = memberlist.ConfigHash
 				}
 				memberlist.Memberlist = append(memberlist.Memberlist, newMember)
 			}
@@ -374,18 +404,48 @@ func (m *MemberList) AddHealthCheckHandler() bool {
 }
 
 // SyncConfig syncs our local config with each member in the cluster.
+// Our config version is incremented first. A member with a newer config rejects ours and we pull theirs instead.
 func (m *MemberList) SyncConfig() error {
 	DB.Logging.Debug("MemberList:SyncConfig() Syncing config with peers..")
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return errors.New("unable to sync config as the local node could not be found")
+	}
+	version := DB.Config.NextVersion(localNode.Hostname)
+	if err := DB.Config.Save(); err != nil {
+		return errors.New("unable to sync config " + err.Error())
+	}
 	// Return with our new updated config
-	buf, err := json.Marshal(DB.Config.GetConfig())
+	buf, err := DB.Config.MarshalReplicated()
 	// Handle failure to marshal config
 	if err != nil {
 		return errors.New("unable to sync config " + err.Error())
 	}
-	m.Broadcast(client.SendConfigSync, &rpc.ConfigSyncRequest{
-		Replicated: true,
-		Config:     buf,
-	})
+	var rejected []string
+	for _, member := range m.GetMembers() {
+		if member.GetHostname() == localNode.Hostname {
+			continue
+		}
+		if err := member.Connect(); err != nil {
+			log.Error("SyncConfig() ", err)
+			continue
+		}
+		r, err := member.Send(client.SendConfigSync, &rpc.ConfigSyncRequest{
+			Replicated: true,
+			Config:     buf,
+		})
+		if err != nil {
+			log.Error("SyncConfig() ", err)
+			continue
+		}
+		if resp := r.(*rpc.ConfigSyncResponse); !resp.Success {
+			rejected = append(rejected, member.GetHostname()+" ("+resp.Message+")")
+			DB.Replication.Observe(member.GetHostname(), versionFromRPC(resp.Version))
+		}
+	}
+	if len(rejected) > 0 {
+		return errors.New("config version " + version.String() + " was rejected by " + strings.Join(rejected, ", "))
+	}
 	return nil
 }
 
@@ -404,7 +464,7 @@ func (m *MemberList) Update(memberlist []*rpc.MemberlistMember) {
 	for _, member := range memberlist {
 		for _, localMember := range m.Members {
 			if member.GetHostname() == localMember.GetHostname() {
-				localMember.SetStatus(member.Status)
+				localMember.ApplyStatus(member.Status)
 				localMember.SetLatency(member.Latency)
 				// Don't replace our local score through the hc updates
 				if localMember.GetHostname() != localNode.Hostname {
@@ -412,8 +472,8 @@ func (m *MemberList) Update(memberlist []*rpc.MemberlistMember) {
 				}
 				// our local last received has priority
 				if member.GetHostname() != localNode.Hostname {
-					tym, _ := time.Parse(time.RFC1123, member.LastReceived)
-					localMember.SetLastHCResponse(tym)
+					localMember.SetLastHCResponse(parseLastReceived(member.LastReceived))
+					localMember.SetConfigHash(member.ConfigHash)
 				}
 				break
 			}
@@ -421,33 +481,134 @@ func (m *MemberList) Update(memberlist []*rpc.MemberlistMember) {
 	}
 }
 
-// GetNextActiveMember calculates who's next to become active in our member list
-// TODO: This needs improvements including the use of plugins. Also failback??
+// parseLastReceived parses the time a member last received a health check.
+// Note: Older members send the time to the second in RFC1123.
+func parseLastReceived(value string) time.Time {
+	if tym, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return tym
+	}
+	tym, _ := time.Parse(time.RFC1123, value)
+	return tym
+}
+
+// GetNextActiveMember calculates who's next to become active in our member list.
+// The passive member with the best rank is chosen. See memberRank.
+// Note: The hostname is used as a final tie breaker so every member makes the same choice.
 func (m *MemberList) GetNextActiveMember() (*Member, error) {
+	var next *Member
+	var nextRank memberRank
 	for _, node := range DB.Config.Nodes {
 		member := m.GetMemberByHostname(node.Hostname)
 		if member == nil {
 			panic("MemberList:getNextActiveMember() Cannot get member by hostname " + node.Hostname)
 		}
-		if member.GetStatus() == rpc.MemberStatus_PASSIVE {
-			log.Debug("MemberList:getNextActiveMember() " + member.GetHostname() + " is the new active appliance")
-			return member, nil
+		// Monitoring nodes and nodes in maintenance are never selected
+		if !node.Electable() {
+			continue
+		}
+		if member.GetStatus() != rpc.MemberStatus_PASSIVE {
+			continue
+		}
+		// With mesh health checks we only choose members we can reach
+		if !meshReachable(node.Hostname) {
+			continue
+		}
+		rank := newMemberRank(*node, member)
+		if next == nil || rank.outranks(nextRank, true) ||
+			(!nextRank.outranks(rank, true) && member.GetHostname() < next.GetHostname()) {
+			next = member
+			nextRank = rank
+		}
+	}
+	if next == nil {
+		return &Member{}, errors.New("MemberList:getNextActiveMember() No new active member found")
+	}
+	log.Debug("MemberList:getNextActiveMember() " + next.GetHostname() + " is the new active appliance")
+	return next, nil
+}
+
+// GetPreferredMember returns the member that should currently be active.
+// With auto failback enabled the preferred node, priority and then score decide, otherwise only the score.
+// Note: The current active member is kept unless another member outranks it.
+func (m *MemberList) GetPreferredMember() (*Member, error) {
+	_, active := m.GetActiveMember()
+	best := active
+	var bestRank memberRank
+	if best != nil {
+		if _, node, err := nodeGetByHostname(best.GetHostname()); err == nil {
+			bestRank = newMemberRank(node, best)
+		}
+	}
+	for _, node := range DB.Config.Nodes {
+		member := m.GetMemberByHostname(node.Hostname)
+		if member == nil || !node.Electable() || member.GetStatus() != rpc.MemberStatus_PASSIVE {
+			continue
 		}
+		rank := newMemberRank(*node, member)
+		// Passive members of the same rank are chosen by hostname so every node makes the same choice
+		if best == nil || rank.outranks(bestRank, DB.Config.Pulse.AutoFailback) ||
+			(best != active && !bestRank.outranks(rank, DB.Config.Pulse.AutoFailback) && member.GetHostname() < best.GetHostname()) {
+			best = member
+			bestRank = rank
+		}
+	}
+	if best == nil {
+		return nil, errors.New("unable to find a member to become active")
 	}
-	return &Member{}, errors.New("MemberList:getNextActiveMember() No new active member found")
+	return best, nil
 }
 
-// TODO: Check if everyone is the same
+// memberRank defines how suitable a member is to become active.
+type memberRank struct {
+	preferred bool
+	priority  int
+	score     int
+}
+
+// newMemberRank returns the rank of a member from its node definition.
+func newMemberRank(node config.Node, member *Member) memberRank {
+	return memberRank{
+		preferred: node.Preferred,
+		priority:  node.Priority,
+		score:     member.GetScore(),
+	}
+}
+
+// outranks determines whether a member with rank r should be active over one with rank o.
+// Members are ranked by the preferred node, then priority and then score.
+// Only the score is compared when the configured preferences are not considered.
+func (r memberRank) outranks(o memberRank, preferences bool) bool {
+	if preferences {
+		if r.preferred != o.preferred {
+			return r.preferred
+		}
+		if r.priority != o.priority {
+			return r.priority > o.priority
+		}
+	}
+	return r.score > o.score
+}
+
+// GetHighestScoreMember returns the member with the highest health check score.
+// Note: Monitoring nodes and nodes in maintenance are never selected.
 func (m *MemberList) GetHighestScoreMember() (*Member, error) {
 	var score int = -1
 	var winningMember *Member
 
-	// First check to see if we all have the same score
+	// Collect the nodes that are able to become active
+	nodes := []*config.Node{}
 	for _, node := range DB.Config.Nodes {
+		if node.Electable() {
+			nodes = append(nodes, node)
+		}
+	}
+
+	// First check to see if we all have the same score
+	for _, node := range nodes {
 		member := m.GetMemberByHostname(node.Hostname)
 
 		// Are we the only member?
-		if len(DB.Config.Nodes) == 1 {
+		if len(nodes) == 1 {
 			return member, nil
 		}
 
@@ -470,7 +631,7 @@ func (m *MemberList) GetHighestScoreMember() (*Member, error) {
 	}
 
 	// otherwise calculate who has the winning score
-	for _, node := range DB.Config.Nodes {
+	for _, node := range nodes {
 		// Get our member by hostname
 		member := m.GetMemberByHostname(node.Hostname)
 
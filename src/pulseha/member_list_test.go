@@ -1 +1,184 @@
 package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"testing"
+	"time"
+)
+
+// setupTestMemberList sets up a member list from node definitions without any connections.
+func setupTestMemberList(nodes map[string]*config.Node, statuses map[string]rpc.MemberStatus_Status) {
+	setupTestDB()
+	DB.Config = &config.Config{Nodes: nodes}
+	DB.MemberList = &MemberList{}
+	for _, node := range nodes {
+		DB.MemberList.Members = append(DB.MemberList.Members, &Member{
+			Hostname: node.Hostname,
+			Status:   statuses[node.Hostname],
+		})
+	}
+}
+
+func TestGetNextActiveMemberSkipsMonitoring(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2", Monitoring: true},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_UNAVAILABLE,
+		"node2": rpc.MemberStatus_PASSIVE,
+	})
+	if _, err := DB.MemberList.GetNextActiveMember(); err == nil {
+		t.Error("expected monitoring node not to be selected")
+	}
+}
+
+func TestGetNextActiveMemberSkipsMaintenance(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2", Maintenance: true, Priority: 10},
+		"c": {Hostname: "node3"},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+		"node3": rpc.MemberStatus_PASSIVE,
+	})
+	member, err := DB.MemberList.GetNextActiveMember()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.GetHostname() != "node3" {
+		t.Errorf("expected node3 to be selected, got %s", member.GetHostname())
+	}
+}
+
+func TestStandbyStatus(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2", Monitoring: true},
+		"c": {Hostname: "node3", Monitoring: true, Maintenance: true},
+	}, nil)
+	want := map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_MONITORING,
+		"node3": rpc.MemberStatus_MAINTENANCE,
+	}
+	for hostname, status := range want {
+		if got := standbyStatus(hostname); got != status {
+			t.Errorf("standbyStatus(%s) = %s, want %s", hostname, got, status)
+		}
+	}
+}
+
+func TestGetHighestScoreMemberSkipsMonitoring(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2", Monitoring: true},
+		"c": {Hostname: "node3"},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_MONITORING,
+		"node3": rpc.MemberStatus_PASSIVE,
+	})
+	DB.MemberList.GetMemberByHostname("node1").Score = 5
+	DB.MemberList.GetMemberByHostname("node2").Score = 50
+	DB.MemberList.GetMemberByHostname("node3").Score = 10
+	member, err := DB.MemberList.GetHighestScoreMember()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.GetHostname() != "node3" {
+		t.Errorf("expected node3 to have the highest score, got %s", member.GetHostname())
+	}
+}
+
+func TestMemberRankOutranks(t *testing.T) {
+	preferred := memberRank{preferred: true, priority: 0, score: 0}
+	priority := memberRank{priority: 10, score: 0}
+	score := memberRank{priority: 0, score: 100}
+	if !preferred.outranks(priority, true) || !priority.outranks(score, true) {
+		t.Error("expected preferred then priority to decide the rank")
+	}
+	if priority.outranks(score, false) || !score.outranks(preferred, false) {
+		t.Error("expected only the score to decide the rank without preferences")
+	}
+	if (memberRank{priority: 1, score: 5}).outranks(memberRank{priority: 1, score: 5}, true) {
+		t.Error("expected equal ranks not to outrank each other")
+	}
+}
+
+func TestGetNextActiveMemberUsesPriority(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2", Priority: 1},
+		"c": {Hostname: "node3", Priority: 1},
+		"d": {Hostname: "node4", Priority: 5},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+		"node3": rpc.MemberStatus_PASSIVE,
+		"node4": rpc.MemberStatus_UNAVAILABLE,
+	})
+	DB.MemberList.GetMemberByHostname("node1").Score = 100
+	DB.MemberList.GetMemberByHostname("node3").Score = 10
+	member, err := DB.MemberList.GetNextActiveMember()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.GetHostname() != "node3" {
+		t.Errorf("expected node3 to be next active, got %s", member.GetHostname())
+	}
+	// Equal ranks are broken by hostname
+	DB.MemberList.GetMemberByHostname("node3").Score = 0
+	if member, _ := DB.MemberList.GetNextActiveMember(); member.GetHostname() != "node2" {
+		t.Errorf("expected node2 to be next active, got %s", member.GetHostname())
+	}
+}
+
+func TestGetPreferredMember(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2", Preferred: true},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+	})
+	DB.Config.Pulse.AutoFailback = true
+	if member, _ := DB.MemberList.GetPreferredMember(); member.GetHostname() != "node2" {
+		t.Errorf("expected the preferred node2 to be chosen, got %s", member.GetHostname())
+	}
+	// Without auto failback the active is kept unless another member has a better score
+	DB.Config.Pulse.AutoFailback = false
+	if member, _ := DB.MemberList.GetPreferredMember(); member.GetHostname() != "node1" {
+		t.Errorf("expected the active node1 to be kept, got %s", member.GetHostname())
+	}
+}
+
+func TestGetPreferredMemberTiebreak(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node3", Priority: 10},
+		"c": {Hostname: "node2", Priority: 10},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_UNAVAILABLE,
+		"node2": rpc.MemberStatus_PASSIVE,
+		"node3": rpc.MemberStatus_PASSIVE,
+	})
+	DB.Config.Pulse.AutoFailback = true
+	// Passive members of the same rank are chosen by hostname whatever the map order
+	for i := 0; i < 20; i++ {
+		if member, _ := DB.MemberList.GetPreferredMember(); member.GetHostname() != "node2" {
+			t.Fatalf("expected node2 to be chosen, got %s", member.GetHostname())
+		}
+	}
+}
+
+func TestParseLastReceived(t *testing.T) {
+	tym := time.Date(2021, 1, 2, 3, 4, 5, 250000000, time.UTC)
+	if got := parseLastReceived(tym.Format(time.RFC3339Nano)); !got.Equal(tym) {
+		t.Errorf("expected millisecond precision, got %s", got)
+	}
+	if got := parseLastReceived(tym.Format(time.RFC1123)); !got.Equal(tym.Truncate(time.Second)) {
+		t.Errorf("expected the RFC1123 format of older members to be parsed, got %s", got)
+	}
+}
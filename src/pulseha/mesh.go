@@ -0,0 +1,233 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/rpc"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Mesh keeps the reachability matrix built from the heartbeats every member sends every other member.
+// Note: Entries older than the failover limit are ignored.
+type Mesh struct {
+	// When we last reached each member
+	reached map[string]time.Time
+	// The members each member reported reaching
+	rows map[string]meshRow
+	// The members we are currently sending a heartbeat to
+	sending map[string]bool
+	sync.Mutex
+}
+
+// meshRow defines the members a member reported reaching.
+type meshRow struct {
+	reachable []string
+	received  time.Time
+}
+
+// meshEnabled determines whether mesh health checks are enabled.
+func meshEnabled() bool {
+	return DB.Config.Pulse.MeshHealthChecks
+}
+
+// meshExpiry returns how long a heartbeat is valid for.
+func meshExpiry() time.Duration {
+	return time.Duration(DB.Config.Pulse.FailOverLimit) * time.Millisecond
+}
+
+// init makes sure our maps exist.
+// Note: The caller must hold the mesh lock.
+func (m *Mesh) init() {
+	if m.reached == nil {
+		m.reached = map[string]time.Time{}
+		m.rows = map[string]meshRow{}
+		m.sending = map[string]bool{}
+	}
+}
+
+// RecordReached records that we reached a member.
+func (m *Mesh) RecordReached(hostname string) {
+	m.Lock()
+	defer m.Unlock()
+	m.init()
+	m.reached[hostname] = time.Now()
+}
+
+// RecordRow records the members a member reported reaching.
+func (m *Mesh) RecordRow(hostname string, reachable []string) {
+	m.Lock()
+	defer m.Unlock()
+	m.init()
+	m.rows[hostname] = meshRow{
+		reachable: append([]string{}, reachable...),
+		received:  time.Now(),
+	}
+}
+
+// Reachable returns the members we have recently reached.
+func (m *Mesh) Reachable() []string {
+	m.Lock()
+	defer m.Unlock()
+	reachable := []string{}
+	for hostname, tym := range m.reached {
+		if time.Since(tym) <= meshExpiry() {
+			reachable = append(reachable, hostname)
+		}
+	}
+	sort.Strings(reachable)
+	return reachable
+}
+
+// Matrix returns the members each member can reach including ourselves.
+func (m *Mesh) Matrix(localHostname string) map[string][]string {
+	reachable := m.Reachable()
+	m.Lock()
+	defer m.Unlock()
+	matrix := map[string][]string{}
+	for hostname, row := range m.rows {
+		if hostname == localHostname || time.Since(row.received) > meshExpiry() {
+			continue
+		}
+		matrix[hostname] = row.reachable
+	}
+	matrix[localHostname] = reachable
+	return matrix
+}
+
+// ReachedBy returns the members other than ourselves that can reach a member.
+func (m *Mesh) ReachedBy(localHostname string, hostname string) []string {
+	var members []string
+	for from, reachable := range m.Matrix(localHostname) {
+		if from == localHostname || from == hostname {
+			continue
+		}
+		for _, to := range reachable {
+			if to == hostname {
+				members = append(members, from)
+				break
+			}
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+// CanReach determines whether we have recently reached a member.
+func (m *Mesh) CanReach(hostname string) bool {
+	for _, reachable := range m.Reachable() {
+		if reachable == hostname {
+			return true
+		}
+	}
+	return false
+}
+
+// beginSend marks a heartbeat to a member as in flight.
+// Returns false if a heartbeat to the member is already in flight.
+func (m *Mesh) beginSend(hostname string) bool {
+	m.Lock()
+	defer m.Unlock()
+	m.init()
+	if m.sending[hostname] {
+		return false
+	}
+	m.sending[hostname] = true
+	return true
+}
+
+// endSend marks a heartbeat to a member as complete.
+func (m *Mesh) endSend(hostname string) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.sending, hostname)
+}
+
+// SendMeshHeartbeats sends a heartbeat to every other member when mesh health checks are enabled.
+// Type: Scheduled function for every member
+func (ml *MemberList) SendMeshHeartbeats(ctx context.Context) bool {
+	// Clear routine
+	if !DB.Config.ClusterCheck() {
+		DB.Logging.Debug("MemberList:SendMeshHeartbeats() routine cleared")
+		return true
+	}
+	if !meshEnabled() {
+		return false
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return true
+	}
+	request := &rpc.HeartbeatRequest{
+		Hostname:  localNode.Hostname,
+		Reachable: DB.Mesh.Reachable(),
+	}
+	for _, member := range ml.GetMembers() {
+		if member.GetHostname() == localNode.Hostname || !DB.Mesh.beginSend(member.GetHostname()) {
+			continue
+		}
+		go func(member *Member) {
+			defer DB.Mesh.endSend(member.GetHostname())
+			if err := member.Connect(); err != nil {
+				return
+			}
+			r, err := member.Send(client.SendHeartbeat, request)
+			if err != nil {
+				DB.Logging.Debug("MemberList:SendMeshHeartbeats() Unable to reach " + member.GetHostname() + ": " + err.Error())
+				return
+			}
+			resp := r.(*rpc.HeartbeatResponse)
+			DB.Mesh.RecordReached(member.GetHostname())
+			DB.Mesh.RecordRow(resp.Hostname, resp.Reachable)
+		}(member)
+	}
+	return false
+}
+
+// activeReachedByPeers returns the members that can still reach the member we believe is active.
+// Note: Used to avoid failing over when only our own link to the active has failed.
+func activeReachedByPeers() (string, []string) {
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return "", nil
+	}
+	for _, member := range DB.MemberList.GetMembers() {
+		status := member.GetStatus()
+		if member.GetHostname() == localNode.Hostname ||
+			(status != rpc.MemberStatus_ACTIVE && status != rpc.MemberStatus_SUSPICIOUS) {
+			continue
+		}
+		return member.GetHostname(), DB.Mesh.ReachedBy(localNode.Hostname, member.GetHostname())
+	}
+	return "", nil
+}
+
+// meshReachable determines whether we can reach a member to make it active.
+// Note: Always true when mesh health checks are disabled.
+func meshReachable(hostname string) bool {
+	if !meshEnabled() {
+		return true
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err == nil && localNode.Hostname == hostname {
+		return true
+	}
+	return DB.Mesh.CanReach(hostname)
+}
@@ -0,0 +1,93 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// setupTestMesh sets up a three node cluster with mesh health checks where node1 is the local node.
+func setupTestMesh() {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2"},
+		"c": {Hostname: "node3", Priority: 10},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_ACTIVE,
+		"node3": rpc.MemberStatus_PASSIVE,
+	})
+	DB.Config.Pulse.LocalNode = "a"
+	DB.Config.Pulse.FailOverLimit = 10000
+	DB.Config.Pulse.MeshHealthChecks = true
+	DB.Mesh = &Mesh{}
+}
+
+func TestMeshMatrix(t *testing.T) {
+	setupTestMesh()
+	DB.Mesh.RecordReached("node3")
+	DB.Mesh.RecordRow("node3", []string{"node1", "node2"})
+	DB.Mesh.RecordRow("node2", []string{"node3"})
+	want := map[string][]string{
+		"node1": {"node3"},
+		"node2": {"node3"},
+		"node3": {"node1", "node2"},
+	}
+	if got := DB.Mesh.Matrix("node1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected matrix %v", got)
+	}
+	// node3 can still reach the active even though we can't
+	if active, peers := activeReachedByPeers(); active != "node2" || !reflect.DeepEqual(peers, []string{"node3"}) {
+		t.Errorf("expected node3 to reach the active node2, got %s %v", active, peers)
+	}
+}
+
+func TestMeshExpiry(t *testing.T) {
+	setupTestMesh()
+	DB.Mesh.RecordReached("node2")
+	DB.Mesh.RecordRow("node3", []string{"node2"})
+	// Age our entries beyond the failover limit
+	DB.Mesh.reached["node2"] = time.Now().Add(-time.Minute)
+	DB.Mesh.rows["node3"] = meshRow{reachable: []string{"node2"}, received: time.Now().Add(-time.Minute)}
+	if DB.Mesh.CanReach("node2") {
+		t.Error("expected an old heartbeat to have expired")
+	}
+	if _, peers := activeReachedByPeers(); len(peers) != 0 {
+		t.Errorf("expected no member to reach the active, got %v", peers)
+	}
+}
+
+func TestGetNextActiveMemberSkipsUnreachable(t *testing.T) {
+	setupTestMesh()
+	DB.MemberList.GetMemberByHostname("node2").Status = rpc.MemberStatus_UNAVAILABLE
+	// node3 outranks us but we can't reach it
+	member, err := DB.MemberList.GetNextActiveMember()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.GetHostname() != "node1" {
+		t.Errorf("expected node1 to be chosen, got %s", member.GetHostname())
+	}
+	DB.Mesh.RecordReached("node3")
+	if member, _ := DB.MemberList.GetNextActiveMember(); member.GetHostname() != "node3" {
+		t.Errorf("expected node3 to be chosen once reachable, got %s", member.GetHostname())
+	}
+}
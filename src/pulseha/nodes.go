@@ -17,6 +17,7 @@
 package pulseha
 
 import (
+	"context"
 	"errors"
 	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
@@ -24,6 +25,7 @@ import (
 	"github.com/syleron/pulseha/packages/language"
 	"github.com/syleron/pulseha/packages/network"
 	"github.com/syleron/pulseha/packages/utils"
+	"github.com/syleron/pulseha/rpc"
 )
 
 // nodeCreateLocal Create a new node definition in the config.
@@ -245,3 +247,138 @@ func nodeUpdateLocalHostname(hostname string) error {
 	}
 	return nil
 }
+
+// nodeIsMonitoring determines whether a node is configured as a monitoring only node.
+func nodeIsMonitoring(hostname string) bool {
+	_, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return false
+	}
+	return node.Monitoring
+}
+
+// nodeSetMonitoring enables or disables monitoring only mode for a node.
+// Note: The active node must hand over to another node before it can become a monitoring node.
+func nodeSetMonitoring(hostname string, enabled bool) error {
+	uid, _, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return err
+	}
+	if enabled {
+		if status, _ := DB.MemberList.MemberGetStatus(hostname); status == rpc.MemberStatus_ACTIVE {
+			return errors.New("unable to make the active node a monitoring node. Promote another node first")
+		}
+	}
+	DB.Config.Lock()
+	DB.Config.Nodes[uid].Monitoring = enabled
+	// A monitoring node can never be the preferred active
+	if enabled {
+		DB.Config.Nodes[uid].Preferred = false
+	}
+	DB.Config.Unlock()
+	if err := DB.Config.Save(); err != nil {
+		return errors.New(language.CLUSTER_CONFIG_FAIL)
+	}
+	return nil
+}
+
+// nodeIsElectable determines whether a node is able to become active.
+func nodeIsElectable(hostname string) bool {
+	_, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return false
+	}
+	return node.Electable()
+}
+
+// standbyStatus returns the status a node should have when it is not active.
+func standbyStatus(hostname string) rpc.MemberStatus_Status {
+	_, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return rpc.MemberStatus_PASSIVE
+	}
+	if node.Maintenance {
+		return rpc.MemberStatus_MAINTENANCE
+	}
+	if node.Monitoring {
+		return rpc.MemberStatus_MONITORING
+	}
+	return rpc.MemberStatus_PASSIVE
+}
+
+// nodeSetPriority sets the failover priority of a node.
+func nodeSetPriority(hostname string, priority int) error {
+	uid, _, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return err
+	}
+	DB.Config.Lock()
+	DB.Config.Nodes[uid].Priority = priority
+	DB.Config.Unlock()
+	if err := DB.Config.Save(); err != nil {
+		return errors.New(language.CLUSTER_CONFIG_FAIL)
+	}
+	return nil
+}
+
+// nodeSetPreferred sets or clears the preferred active node.
+// Note: Only one node can be preferred so any other preferred node is cleared.
+func nodeSetPreferred(hostname string, preferred bool) error {
+	uid, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return err
+	}
+	if preferred && node.Monitoring {
+		return errors.New("a monitoring node cannot be the preferred active node")
+	}
+	DB.Config.Lock()
+	for key, node := range DB.Config.Nodes {
+		if key == uid {
+			node.Preferred = preferred
+		} else if preferred {
+			node.Preferred = false
+		}
+	}
+	DB.Config.Unlock()
+	if err := DB.Config.Save(); err != nil {
+		return errors.New(language.CLUSTER_CONFIG_FAIL)
+	}
+	return nil
+}
+
+// nodeSetMaintenance moves a node in or out of maintenance.
+// An active node first hands over to the next eligible member.
+func nodeSetMaintenance(ctx context.Context, hostname string, enabled bool) error {
+	uid, _, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return err
+	}
+	status, _ := DB.MemberList.MemberGetStatus(hostname)
+	if enabled && status == rpc.MemberStatus_ACTIVE {
+		// Hand over to the next eligible member first.
+		// Note: The node stays electable until then so a failed switchover can be rolled back.
+		next, err := DB.MemberList.GetNextActiveMember()
+		if err == nil {
+			err = DB.MemberList.PromoteMember(ctx, next.GetHostname())
+		}
+		if err != nil {
+			return errors.New("unable to hand over to another member: " + err.Error())
+		}
+	}
+	return nodeUpdateMaintenance(uid, enabled)
+}
+
+// nodeUpdateMaintenance saves the maintenance state of a node and syncs it with our peers.
+func nodeUpdateMaintenance(uid string, enabled bool) error {
+	DB.Config.Lock()
+	DB.Config.Nodes[uid].Maintenance = enabled
+	DB.Config.Unlock()
+	if err := DB.Config.Save(); err != nil {
+		return errors.New(language.CLUSTER_CONFIG_FAIL)
+	}
+	// Our saved config applies locally even when our peers couldn't be synced
+	err := DB.MemberList.SyncConfig()
+	DB.MemberList.RefreshStandbyStatus()
+	reconcileLocalGroups(false)
+	return err
+}
@@ -0,0 +1,180 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"errors"
+	"fmt"
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/packages/utils"
+	"github.com/syleron/pulseha/rpc"
+	"google.golang.org/grpc/connectivity"
+	"net"
+	"sync"
+	"time"
+)
+
+// Path defines a single heartbeat path to a member.
+type Path struct {
+	// The ip:port address of the path
+	Address string
+	// Whether the last health check or probe over the path succeeded
+	Up bool
+	// The latency of the last health check or probe over the path
+	Latency string
+	// The client used for the path
+	// Note: The first path uses the member client.
+	client *client.Client
+}
+
+// syncPaths makes sure our heartbeat paths match the member's configuration.
+func (m *Member) syncPaths() []*Path {
+	_, node, err := nodeGetByHostname(m.GetHostname())
+	if err != nil {
+		return nil
+	}
+	addresses := node.HeartbeatPaths()
+	m.Lock()
+	defer m.Unlock()
+	existing := map[string]*Path{}
+	for _, path := range m.Paths {
+		existing[path.Address] = path
+	}
+	paths := []*Path{}
+	for i, address := range addresses {
+		path, ok := existing[address]
+		if !ok {
+			path = &Path{Address: address}
+		}
+		delete(existing, address)
+		if i == 0 {
+			path.client = m.Client
+		} else if path.client == nil || path.client == m.Client {
+			path.client = &client.Client{}
+		}
+		paths = append(paths, path)
+	}
+	// Close the paths that are no longer configured
+	for _, path := range existing {
+		if path.client != nil && path.client != m.Client {
+			path.client.Close()
+		}
+	}
+	m.Paths = paths
+	return paths
+}
+
+// GetPaths returns the status of each heartbeat path to the member.
+func (m *Member) GetPaths() []Path {
+	m.Lock()
+	defer m.Unlock()
+	paths := []Path{}
+	for _, path := range m.Paths {
+		paths = append(paths, Path{
+			Address: path.Address,
+			Up:      path.Up,
+			Latency: path.Latency,
+		})
+	}
+	return paths
+}
+
+// setPathStatus records the result of a health check or probe over a path.
+func (m *Member) setPathStatus(path *Path, up bool, latency time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+	path.Up = up
+	path.Latency = ""
+	if up {
+		path.Latency = fmt.Sprint(latency.Round(time.Millisecond))
+	}
+}
+
+// connectPath connects the client of an additional heartbeat path unless it is already connected.
+// Note: The member lock is held as Close and syncPaths change our paths at the same time.
+func (m *Member) connectPath(path *Path) error {
+	m.Lock()
+	defer m.Unlock()
+	if path.client.Connection != nil && path.client.Connection.GetState() != connectivity.Shutdown {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(path.Address)
+	if err != nil {
+		return err
+	}
+	path.client.ServerName = m.Hostname
+	return path.client.Connect(utils.FormatIPv6(host), port, true)
+}
+
+// sendOverPath sends an RPC command over a particular heartbeat path.
+func (m *Member) sendOverPath(path *Path, primary bool, funcName client.ProtoFunction, data interface{}) (interface{}, error) {
+	startTime := time.Now()
+	var err error
+	if primary {
+		err = m.Connect()
+	} else {
+		err = m.connectPath(path)
+	}
+	if err != nil {
+		m.setPathStatus(path, false, 0)
+		return nil, err
+	}
+	// Send using the connection as it is now as Close and syncPaths may replace it while we wait
+	m.Lock()
+	pathClient := *path.client
+	m.Unlock()
+	r, err := pathClient.SendTimeout(funcName, data, healthCheckTimeout())
+	m.setPathStatus(path, err == nil, time.Since(startTime))
+	return r, err
+}
+
+// sendHealthCheckOverPaths sends a health check over the first heartbeat path that works.
+// The other paths are probed so their status is known. An error is only returned when every path is down.
+func (m *Member) sendHealthCheckOverPaths(data *rpc.HealthCheckRequest) (interface{}, error) {
+	paths := m.syncPaths()
+	if len(paths) == 0 {
+		return nil, errors.New("unable to find heartbeat paths for " + m.GetHostname())
+	}
+	// Probe our additional paths while the health check is sent
+	var wg sync.WaitGroup
+	for _, path := range paths[1:] {
+		wg.Add(1)
+		go func(path *Path) {
+			defer wg.Done()
+			m.sendOverPath(path, false, client.SendDescribe, &rpc.DescribeRequest{})
+		}(path)
+	}
+	r, err := m.sendOverPath(paths[0], true, client.SendHealthCheck, data)
+	wg.Wait()
+	if err == nil {
+		return r, nil
+	}
+	// Fall back to any additional path that is up
+	for _, path := range paths[1:] {
+		m.Lock()
+		up := path.Up
+		m.Unlock()
+		if !up {
+			continue
+		}
+		DB.Logging.Debug("Member:sendHealthCheckOverPaths() Sending health check to " + m.GetHostname() + " over " + path.Address)
+		if r, pathErr := m.sendOverPath(path, false, client.SendHealthCheck, data); pathErr == nil {
+			return r, nil
+		}
+	}
+	return nil, err
+}
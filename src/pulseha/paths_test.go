@@ -0,0 +1,123 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"sync"
+	"testing"
+	"time"
+)
+
+// setupTestPaths sets up a two node cluster where node2 has a crossover heartbeat path.
+func setupTestPaths() *Member {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1", IP: "10.0.0.1", Port: "1234"},
+		"b": {Hostname: "node2", IP: "10.0.0.2", Port: "1234", HeartbeatAddresses: []string{"192.168.100.2:1234"}},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+	})
+	DB.Config.Pulse.LocalNode = "a"
+	member := DB.MemberList.GetMemberByHostname("node2")
+	member.Client = &client.Client{}
+	return member
+}
+
+func TestSyncPaths(t *testing.T) {
+	member := setupTestPaths()
+	paths := member.syncPaths()
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 paths, got %d", len(paths))
+	}
+	if paths[0].Address != "10.0.0.2:1234" || paths[1].Address != "192.168.100.2:1234" {
+		t.Errorf("unexpected path addresses %s, %s", paths[0].Address, paths[1].Address)
+	}
+	if paths[0].client != member.Client {
+		t.Error("expected the bind address to use the member client")
+	}
+	if paths[1].client == nil || paths[1].client == member.Client {
+		t.Error("expected the heartbeat address to use its own client")
+	}
+}
+
+func TestSyncPathsKeepsStatus(t *testing.T) {
+	member := setupTestPaths()
+	paths := member.syncPaths()
+	member.setPathStatus(paths[1], true, 3*time.Millisecond)
+	paths = member.syncPaths()
+	if !paths[1].Up || paths[1].Latency != "3ms" {
+		t.Errorf("expected the path status to be kept, got up=%v latency=%s", paths[1].Up, paths[1].Latency)
+	}
+}
+
+func TestSyncPathsRemovesPath(t *testing.T) {
+	member := setupTestPaths()
+	member.syncPaths()
+	DB.Config.Nodes["b"].HeartbeatAddresses = nil
+	if paths := member.syncPaths(); len(paths) != 1 {
+		t.Errorf("expected 1 path after removing the heartbeat address, got %d", len(paths))
+	}
+	if paths := member.GetPaths(); len(paths) != 1 || paths[0].Address != "10.0.0.2:1234" {
+		t.Errorf("unexpected paths %v", paths)
+	}
+}
+
+func TestSendHealthCheckOverPathsUnknownMember(t *testing.T) {
+	setupTestPaths()
+	member := &Member{Hostname: "node3", Client: &client.Client{}}
+	if _, err := member.sendHealthCheckOverPaths(&rpc.HealthCheckRequest{}); err == nil {
+		t.Error("expected an error for a member without heartbeat paths")
+	}
+}
+
+func TestGetNodeHostnameByHeartbeatAddress(t *testing.T) {
+	setupTestPaths()
+	hostname, err := DB.Config.GetNodeHostnameByAddress("192.168.100.2")
+	if err != nil || hostname != "node2" {
+		t.Errorf("expected node2, got %s (%v)", hostname, err)
+	}
+	if _, err := DB.Config.GetNodeHostnameByAddress("192.168.100.3"); err == nil {
+		t.Error("expected an error for an unknown address")
+	}
+}
+
+// Note: Run with -race to detect unsynchronised access to our paths.
+func TestPathsConcurrentAccess(t *testing.T) {
+	member := setupTestPaths()
+	setupTestCerts(t)
+	paths := member.syncPaths()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			member.sendOverPath(paths[1], false, client.SendDescribe, &rpc.DescribeRequest{})
+		}()
+		go func() {
+			defer wg.Done()
+			member.Close()
+		}()
+		go func() {
+			defer wg.Done()
+			member.syncPaths()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,177 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"errors"
+	"github.com/syleron/pulseha/packages/language"
+	"github.com/syleron/pulseha/rpc"
+	"sort"
+	"sync"
+)
+
+// Placement tracks the floating IP groups that are up on the local node.
+type Placement struct {
+	// The groups that are up and the interface they are up on
+	local map[string]string
+	sync.Mutex
+}
+
+// setUp records a group as being up on the local node.
+func (p *Placement) setUp(group string, iface string) {
+	p.Lock()
+	defer p.Unlock()
+	if p.local == nil {
+		p.local = map[string]string{}
+	}
+	p.local[group] = iface
+}
+
+// setDown records a group as being down on the local node.
+func (p *Placement) setDown(group string) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.local, group)
+}
+
+// IsUp returns whether a group is up on the local node.
+func (p *Placement) IsUp(group string) bool {
+	p.Lock()
+	defer p.Unlock()
+	_, ok := p.local[group]
+	return ok
+}
+
+// Groups returns a copy of the groups that are up on the local node and their interface.
+func (p *Placement) Groups() map[string]string {
+	p.Lock()
+	defer p.Unlock()
+	groups := map[string]string{}
+	for group, iface := range p.local {
+		groups[group] = iface
+	}
+	return groups
+}
+
+// groupOwner returns the hostname of the member that should hold a floating IP group.
+// A group placed on a member is held by that member while it is available,
+// otherwise the group is held by the active member.
+func groupOwner(group string) string {
+	if owner := DB.Config.GroupOwners[group]; owner != "" {
+		status, err := DB.MemberList.MemberGetStatus(owner)
+		if err == nil && (status == rpc.MemberStatus_ACTIVE || status == rpc.MemberStatus_PASSIVE) {
+			return owner
+		}
+	}
+	hostname, _ := DB.MemberList.GetActiveMember()
+	return hostname
+}
+
+// memberGroups returns the floating IP groups currently held by a member.
+func memberGroups(hostname string) []string {
+	groups := []string{}
+	for group := range DB.Config.Groups {
+		if groupOwner(group) == hostname {
+			groups = append(groups, group)
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// reconcileLocalGroups brings up the groups the local node should hold and brings down the rest.
+// Note: When forced every group is brought up or down regardless of its recorded state.
+func reconcileLocalGroups(force bool) {
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return
+	}
+	for iface, groups := range localNode.IPGroups {
+		for _, group := range groups {
+			owned := groupOwner(group) == localNode.Hostname
+			up := DB.Placement.IsUp(group)
+			if owned && (force || !up) {
+				makeGroupActive(iface, group)
+			} else if !owned && (force || up) {
+				makeGroupPassive(iface, group)
+			}
+		}
+	}
+}
+
+// releaseHeldGroups brings down the groups that are currently up on the local node.
+func releaseHeldGroups() {
+	for group, iface := range DB.Placement.Groups() {
+		makeGroupPassive(iface, group)
+	}
+}
+
+// releaseLocalGroups brings down every group assigned to the local node.
+// Note: Groups placed on the local node are kept unless all is set.
+func releaseLocalGroups(all bool) {
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return
+	}
+	for iface, groups := range localNode.IPGroups {
+		for _, group := range groups {
+			if !all && DB.Config.GroupOwners[group] == localNode.Hostname {
+				continue
+			}
+			makeGroupPassive(iface, group)
+		}
+	}
+}
+
+// groupPlace places a floating IP group on a particular node.
+// An empty hostname removes the placement so the group follows the active node.
+func groupPlace(groupName string, hostname string) error {
+	DB.Config.Lock()
+	defer DB.Config.Unlock()
+	if !groupExist(groupName) {
+		return errors.New("IP group does not exist")
+	}
+	if hostname == "" {
+		delete(DB.Config.GroupOwners, groupName)
+		return nil
+	}
+	uid, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return errors.New(language.CLUSTER_NODE_NOTFOUND)
+	}
+	if node.Monitoring {
+		return errors.New("unable to place a group on a monitoring node")
+	}
+	if !nodeHasGroup(uid, groupName) {
+		return errors.New(groupName + " must be assigned to an interface on " + hostname + " before it can be placed there")
+	}
+	if DB.Config.GroupOwners == nil {
+		DB.Config.GroupOwners = map[string]string{}
+	}
+	DB.Config.GroupOwners[groupName] = hostname
+	return nil
+}
+
+// nodeHasGroup determines whether a group is assigned to any interface of a node.
+func nodeHasGroup(uid string, groupName string) bool {
+	for iface := range DB.Config.Nodes[uid].IPGroups {
+		if exists, _ := nodeInterfaceGroupExists(uid, iface, groupName); exists {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"reflect"
+	"testing"
+)
+
+func TestGroupOwner(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2"},
+		"c": {Hostname: "node3"},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+		"node3": rpc.MemberStatus_UNAVAILABLE,
+	})
+	DB.Config.Groups = map[string][]string{
+		"group1": {},
+		"group2": {},
+		"group3": {},
+	}
+	DB.Config.GroupOwners = map[string]string{
+		"group2": "node2",
+		"group3": "node3",
+	}
+	cases := map[string]string{
+		// Follows the active
+		"group1": "node1",
+		// Placed on an available member
+		"group2": "node2",
+		// Placed on an unavailable member so taken over by the active
+		"group3": "node1",
+	}
+	for group, want := range cases {
+		if got := groupOwner(group); got != want {
+			t.Errorf("groupOwner(%s) = %s, want %s", group, got, want)
+		}
+	}
+	if got := memberGroups("node1"); !reflect.DeepEqual(got, []string{"group1", "group3"}) {
+		t.Errorf("expected node1 to hold group1 and group3, got %v", got)
+	}
+}
+
+func TestPlacementTracking(t *testing.T) {
+	p := &Placement{}
+	p.setUp("group1", "eth0")
+	if !p.IsUp("group1") || p.IsUp("group2") {
+		t.Fatal("expected only group1 to be up")
+	}
+	p.setDown("group1")
+	if p.IsUp("group1") || len(p.Groups()) != 0 {
+		t.Error("expected group1 to be down")
+	}
+}
@@ -17,13 +17,18 @@
 package pulseha
 
 import (
+	"context"
 	log "github.com/sirupsen/logrus"
+	"github.com/syleron/pulseha/packages/config"
 	"path"
 	"path/filepath"
 	"plugin"
 	"strconv"
 )
 
+// PluginDir is the folder plugins are loaded from.
+var PluginDir = config.DefaultPluginDir
+
 // PluginHC is the health check object structure
 type PluginHC interface {
 	Name() string
@@ -50,6 +55,13 @@ type PluginGen interface {
 	OnMemberFailover(member Member)
 }
 
+// PluginFence is the fencing plugin object structure
+type PluginFence interface {
+	Name() string
+	Version() float64
+	Fence(ctx context.Context, hostname string) error
+}
+
 // Plugins object structure which stores our plugins
 type Plugins struct {
 	modules []*Plugin
@@ -69,12 +81,14 @@ const (
 	PluginHealthCheck pluginType = 1 + iota
 	PluginNetworking
 	PluginGeneral
+	PluginFencing
 )
 
 var pluginTypeNames = []string{
 	"PluginHC",
 	"PluginNet",
 	"PluginGeneral",
+	"PluginFence",
 }
 
 func (p pluginType) String() string {
@@ -84,7 +98,7 @@ func (p pluginType) String() string {
 // Setup defines each type of plugin to load
 func (p *Plugins) Setup() {
 	// Join any number of file paths into a single path
-	evtGlob := path.Join("/usr/local/lib/pulseha/", "/*.so")
+	evtGlob := path.Join(PluginDir, "/*.so")
 	// Return all the files that match the file name pattern
 	evt, err := filepath.Glob(evtGlob)
 	// handle errors
@@ -105,6 +119,7 @@ func (p *Plugins) Setup() {
 	p.Load(PluginHealthCheck, plugins)
 	p.Load(PluginNetworking, plugins)
 	p.Load(PluginGeneral, plugins)
+	p.Load(PluginFencing, plugins)
 	p.Validate()
 	if len(p.modules) > 0 {
 		var pluginNames string = ""
@@ -193,6 +208,25 @@ func (p *Plugins) Load(pluginType pluginType, pluginList []*plugin.Plugin) {
 			}
 			// Add to the list of plugins
 			p.modules = append(p.modules, newPlugin)
+		case PluginFencing:
+			symEvt, err := plugin.Lookup(pluginType.String())
+			if err != nil {
+				log.Debugf("Plugin does not match pluginType symbol: %v", err)
+				continue
+			}
+			e, ok := symEvt.(PluginFence)
+			if !ok {
+				continue
+			}
+			// Create a new instance of plugins
+			newPlugin := &Plugin{
+				Name:    e.Name(),
+				Version: e.Version(),
+				Type:    pluginType,
+				Plugin:  e,
+			}
+			// Add to the list of plugins
+			p.modules = append(p.modules, newPlugin)
 		}
 	}
 }
@@ -228,3 +262,13 @@ func (p *Plugins) GetGeneralPlugins() []*Plugin {
 	}
 	return modules
 }
+
+// GetFencingPlugin is used to find a fencing plugin by name
+func (p *Plugins) GetFencingPlugin(name string) *Plugin {
+	for _, plgin := range p.modules {
+		if plgin.Type == PluginFencing && plgin.Name == name {
+			return plgin
+		}
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// resyncInterval is how often we re-sync a member whose config has drifted.
+const resyncInterval = 5 * time.Second
+
+// Replication keeps the cluster config of every member in sync using the config version.
+// A config is only replaced by a config with a version that supersedes it.
+type Replication struct {
+	// Whether we are currently pulling a newer config
+	pulling bool
+	// The members whose config has drifted from ours
+	drifted map[string]bool
+	// When we last re-synced each drifted member
+	resynced map[string]time.Time
+	// The re-syncs in flight
+	pushes sync.WaitGroup
+	// Serialises changes to our config
+	applying sync.Mutex
+	sync.Mutex
+}
+
+// versionToRPC converts a config version to its RPC form.
+func versionToRPC(v config.Version) *rpc.ConfigVersion {
+	return &rpc.ConfigVersion{
+		Number: v.Number,
+		Origin: v.Origin,
+	}
+}
+
+// versionFromRPC converts a config version from its RPC form.
+// Note: Members that don't send a version are treated as version 0.
+func versionFromRPC(v *rpc.ConfigVersion) config.Version {
+	if v == nil {
+		return config.Version{}
+	}
+	return config.Version{
+		Number: v.Number,
+		Origin: v.Origin,
+	}
+}
+
+// Apply replaces our cluster config with a replicated config unless ours is newer.
+// Note: Our pulseha and plugins sections are always kept.
+func (r *Replication) Apply(newConfig *config.Config) error {
+	r.applying.Lock()
+	defer r.applying.Unlock()
+	if DB.Config.Version.Supersedes(newConfig.Version) {
+		return errors.New("rejected stale config version " + newConfig.Version.String() +
+			" as the local config version is " + DB.Config.Version.String())
+	}
+	// !!!IMPORTANT!!!: Do not replace our local config
+	newConfig.Pulse = DB.Config.Pulse
+	// !!!IMPORTANT!!!: Do not replace our plugins config
+	newConfig.Plugins = DB.Config.Plugins
+	// Set our new config in memory
+	DB.SetConfig(newConfig)
+	// Save our config to file
+	if err := DB.Config.Save(); err != nil {
+		return err
+	}
+	// Update our member list
+	DB.MemberList.Reload()
+	// Apply any monitoring node changes
+	DB.MemberList.RefreshStandbyStatus()
+	// Apply any group placement changes
+	reconcileLocalGroups(false)
+	DB.Logging.Info("Applied config version " + newConfig.Version.String())
+	return nil
+}
+
+// Rollback restores one of our config backups.
+// A replicated rollback only restores the cluster config which is given a new version and synced to every member.
+// Note: Without replication a clustered node only restores its pulseha and plugins sections
+// as the active re-syncs our cluster config if it has drifted from its own.
+func (r *Replication) Rollback(index int, replicate bool) error {
+	backup, err := config.LoadBackup(index)
+	if err != nil {
+		return err
+	}
+	r.applying.Lock()
+	if replicate {
+		// !!!IMPORTANT!!!: Do not replace our local or plugins config
+		backup.Pulse = DB.Config.Pulse
+		backup.Plugins = DB.Config.Plugins
+		// Continue from our current version so every member accepts the rollback
+		backup.Version = DB.Config.Version
+	} else if DB.Config.ClusterCheck() {
+		// !!!IMPORTANT!!!: Do not replace the cluster config
+		backup.Groups = DB.Config.Groups
+		backup.Nodes = DB.Config.Nodes
+		backup.GroupOwners = DB.Config.GroupOwners
+		backup.Version = DB.Config.Version
+		backup.HeartbeatKey = DB.Config.HeartbeatKey
+	}
+	if err := backup.Validate(); err != nil {
+		r.applying.Unlock()
+		return errors.New("unable to restore config backup: " + err.Error())
+	}
+	DB.SetConfig(backup)
+	// Our config file is only replaced once the backup has been saved
+	DB.MemberList.LoadMembers()
+	if replicate && DB.Config.ClusterCheck() {
+		err = DB.MemberList.SyncConfig()
+	} else {
+		err = DB.Config.Save()
+	}
+	DB.MemberList.RefreshStandbyStatus()
+	reconcileLocalGroups(false)
+	r.applying.Unlock()
+	if err != nil {
+		return err
+	}
+	DB.Logging.Info("Restored config backup " + strconv.Itoa(index))
+	return nil
+}
+
+// Observe pulls the config of a member that reported a newer config version.
+// Note: Only one pull happens at a time.
+func (r *Replication) Observe(hostname string, version config.Version) {
+	if !version.Supersedes(DB.Config.Version) {
+		return
+	}
+	r.Lock()
+	if r.pulling {
+		r.Unlock()
+		return
+	}
+	r.pulling = true
+	r.Unlock()
+	go func() {
+		defer func() {
+			r.Lock()
+			r.pulling = false
+			r.Unlock()
+		}()
+		DB.Logging.Info(hostname + " has config version " + version.String() + ". Pulling config..")
+		if err := r.Pull(hostname); err != nil {
+			DB.Logging.Warn("Unable to pull config from " + hostname + ": " + err.Error())
+		}
+	}()
+}
+
+// Reconcile compares the config a member reported with ours.
+// We pull the config of a member with a newer version and re-sync a member whose config has drifted from ours.
+// Type: Active node function
+func (r *Replication) Reconcile(hostname string, version config.Version, hash string) {
+	if version.Supersedes(DB.Config.Version) {
+		r.Observe(hostname, version)
+		return
+	}
+	if hash == "" || hash == DB.Config.Hash() {
+		r.Lock()
+		delete(r.drifted, hostname)
+		delete(r.resynced, hostname)
+		r.Unlock()
+		return
+	}
+	r.Lock()
+	if r.drifted == nil {
+		r.drifted = map[string]bool{}
+		r.resynced = map[string]time.Time{}
+	}
+	if time.Since(r.resynced[hostname]) < resyncInterval {
+		r.Unlock()
+		return
+	}
+	r.resynced[hostname] = time.Now()
+	detected := !r.drifted[hostname]
+	r.drifted[hostname] = true
+	r.Unlock()
+	// Record the drift once until the member is back in sync
+	if detected {
+		message := "Config drift detected on " + hostname + ". Re-syncing config version " + DB.Config.Version.String()
+		DB.Logging.Warn(message)
+		if DB.Events != nil {
+			DB.Events.Record(EventConfigDrift, hostname, message)
+		}
+	}
+	r.pushes.Add(1)
+	go func() {
+		defer r.pushes.Done()
+		if err := r.Push(hostname); err != nil {
+			DB.Logging.Warn("Unable to re-sync config with " + hostname + ": " + err.Error())
+		}
+	}()
+}
+
+// Push sends our config to a member without changing its version.
+func (r *Replication) Push(hostname string) error {
+	member := DB.MemberList.GetMemberByHostname(hostname)
+	if member == nil {
+		return errors.New("unable to find member " + hostname)
+	}
+	buf, err := DB.Config.MarshalReplicated()
+	if err != nil {
+		return err
+	}
+	if err := member.Connect(); err != nil {
+		return err
+	}
+	resp, err := member.Send(client.SendConfigSync, &rpc.ConfigSyncRequest{
+		Replicated: true,
+		Config:     buf,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.(*rpc.ConfigSyncResponse).Success {
+		return errors.New(resp.(*rpc.ConfigSyncResponse).Message)
+	}
+	return nil
+}
+
+// configState describes whether the config a member last reported matches ours.
+func configState(member *Member) string {
+	hash := member.GetConfigHash()
+	if localNode, err := DB.Config.GetLocalNode(); err == nil && localNode.Hostname == member.GetHostname() {
+		hash = DB.Config.Hash()
+	}
+	switch hash {
+	case "":
+		return ""
+	case DB.Config.Hash():
+		return "in sync"
+	default:
+		return "drift"
+	}
+}
+
+// fetchVersion requests the config version and optionally the config of a member.
+func fetchVersion(member *Member, includeConfig bool) (*rpc.ConfigVersionResponse, error) {
+	if err := member.Connect(); err != nil {
+		return nil, err
+	}
+	r, err := member.Send(client.SendConfigVersion, &rpc.ConfigVersionRequest{
+		Config: includeConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := r.(*rpc.ConfigVersionResponse)
+	if !resp.Success {
+		return nil, errors.New(resp.Message)
+	}
+	return resp, nil
+}
+
+// Pull fetches the config of a member and applies it.
+func (r *Replication) Pull(hostname string) error {
+	member := DB.MemberList.GetMemberByHostname(hostname)
+	if member == nil {
+		return errors.New("unable to find member " + hostname)
+	}
+	resp, err := fetchVersion(member, true)
+	if err != nil {
+		return err
+	}
+	newConfig := &config.Config{}
+	if err := json.Unmarshal(resp.Config, newConfig); err != nil {
+		return err
+	}
+	return r.Apply(newConfig)
+}
+
+// PullNewest pulls the newest config from the other members.
+// Type: Called when we start so a member that was offline catches up with any changes it missed.
+func (r *Replication) PullNewest() {
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return
+	}
+	var newest string
+	newestVersion := DB.Config.Version
+	for _, member := range DB.MemberList.GetMembers() {
+		if member.GetHostname() == localNode.Hostname {
+			continue
+		}
+		resp, err := fetchVersion(member, false)
+		if err != nil {
+			DB.Logging.Debug("Replication:PullNewest() Unable to get the config version of " + member.GetHostname() + ": " + err.Error())
+			continue
+		}
+		if version := versionFromRPC(resp.Version); version.Supersedes(newestVersion) {
+			newest = member.GetHostname()
+			newestVersion = version
+		}
+	}
+	if newest == "" {
+		DB.Logging.Debug("Replication:PullNewest() Local config version " + DB.Config.Version.String() + " is the newest")
+		return
+	}
+	r.Observe(newest, newestVersion)
+}
+
+// Versions returns the config version of every member.
+func (r *Replication) Versions() []*rpc.ConfigVersionRow {
+	localNode, _ := DB.Config.GetLocalNode()
+	rows := []*rpc.ConfigVersionRow{}
+	for _, member := range DB.MemberList.GetMembers() {
+		row := &rpc.ConfigVersionRow{
+			Hostname: member.GetHostname(),
+		}
+		if member.GetHostname() == localNode.Hostname {
+			row.Version = versionToRPC(DB.Config.Version)
+		} else if resp, err := fetchVersion(member, false); err != nil {
+			row.Error = err.Error()
+		} else {
+			row.Version = resp.Version
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
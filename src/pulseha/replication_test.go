@@ -0,0 +1,241 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"encoding/json"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/packages/utils"
+	"github.com/syleron/pulseha/rpc"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestVersionSupersedes(t *testing.T) {
+	tests := []struct {
+		v, o config.Version
+		want bool
+	}{
+		{config.Version{Number: 2, Origin: "node2"}, config.Version{Number: 1, Origin: "node1"}, true},
+		{config.Version{Number: 1, Origin: "node1"}, config.Version{Number: 2, Origin: "node2"}, false},
+		{config.Version{Number: 2, Origin: "node1"}, config.Version{Number: 2, Origin: "node1"}, false},
+		// Concurrent changes are resolved in favour of the lowest hostname
+		{config.Version{Number: 2, Origin: "node1"}, config.Version{Number: 2, Origin: "node2"}, true},
+		{config.Version{Number: 2, Origin: "node2"}, config.Version{Number: 2, Origin: "node1"}, false},
+		{config.Version{Number: 2, Origin: "node1"}, config.Version{Number: 2}, true},
+		{config.Version{}, config.Version{}, false},
+	}
+	for _, test := range tests {
+		if got := test.v.Supersedes(test.o); got != test.want {
+			t.Errorf("expected %s superseding %s to be %v", test.v, test.o, test.want)
+		}
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	c := &config.Config{Version: config.Version{Number: 4, Origin: "node2"}}
+	if v := c.NextVersion("node1"); v.Number != 5 || v.Origin != "node1" || c.Version != v {
+		t.Errorf("expected version 5 (node1), got %s", c.Version)
+	}
+}
+
+func TestReplicationApplyRejectsStale(t *testing.T) {
+	setupTestPaths()
+	DB.Config.Version = config.Version{Number: 5, Origin: "node1"}
+	r := &Replication{}
+	stale := &config.Config{
+		Nodes:   map[string]*config.Node{},
+		Version: config.Version{Number: 4, Origin: "node2"},
+	}
+	if err := r.Apply(stale); err == nil {
+		t.Fatal("expected a stale config to be rejected")
+	}
+	if len(DB.Config.Nodes) != 2 || DB.Config.Version.Number != 5 {
+		t.Error("expected the local config to be kept")
+	}
+}
+
+func TestReplicationObserveIgnoresOlder(t *testing.T) {
+	setupTestPaths()
+	DB.Config.Version = config.Version{Number: 5, Origin: "node1"}
+	r := &Replication{}
+	r.Observe("node2", config.Version{Number: 5, Origin: "node1"})
+	r.Observe("node2", config.Version{Number: 5, Origin: "node2"})
+	r.Observe("node2", config.Version{Number: 4, Origin: "node1"})
+	if r.pulling {
+		t.Error("expected no pull for a version that isn't newer")
+	}
+}
+
+func TestVersionFromRPC(t *testing.T) {
+	if v := versionFromRPC(nil); v != (config.Version{}) {
+		t.Errorf("expected version 0 for a member without a version, got %s", v)
+	}
+	v := config.Version{Number: 3, Origin: "node2"}
+	if got := versionFromRPC(versionToRPC(v)); got != v {
+		t.Errorf("expected %s, got %s", v, got)
+	}
+}
+
+func TestHealthCheckResponseCarriesVersion(t *testing.T) {
+	setupTestPaths()
+	DB.Election = &Election{}
+	DB.Config.Version = config.Version{Number: 7, Origin: "node2"}
+	resp := healthCheckResponse(DB.MemberList.GetMemberByHostname("node1"))
+	if got := versionFromRPC(resp.ConfigVersion); got != DB.Config.Version {
+		t.Errorf("expected config version %s, got %s", DB.Config.Version, got)
+	}
+}
+
+func TestConfigHashIgnoresLocalSections(t *testing.T) {
+	a := &config.Config{
+		Pulse:  config.Local{LocalNode: "a"},
+		Groups: map[string][]string{"group1": {"10.0.0.10/24"}},
+		Nodes:  map[string]*config.Node{"a": {Hostname: "node1"}},
+	}
+	b := &config.Config{
+		Pulse:       config.Local{LocalNode: "b"},
+		Plugins:     map[string]interface{}{"plugin": true},
+		Groups:      map[string][]string{"group1": {"10.0.0.10/24"}},
+		Nodes:       map[string]*config.Node{"a": {Hostname: "node1"}},
+		GroupOwners: map[string]string{},
+	}
+	if a.Hash() != b.Hash() {
+		t.Error("expected the local sections and empty group owners not to change the hash")
+	}
+	b.Groups["group1"] = []string{"10.0.0.11/24"}
+	if a.Hash() == b.Hash() {
+		t.Error("expected a group change to change the hash")
+	}
+}
+
+func TestMarshalReplicated(t *testing.T) {
+	c := &config.Config{
+		Pulse:        config.Local{LocalNode: "a", ClusterToken: "hash"},
+		Plugins:      map[string]interface{}{"plugin": true},
+		Groups:       map[string][]string{"group1": {"10.0.0.10/24"}},
+		Nodes:        map[string]*config.Node{"a": {Hostname: "node1"}},
+		Version:      config.Version{Number: 3, Origin: "node1"},
+		HeartbeatKey: "key",
+	}
+	buf, err := c.MarshalReplicated()
+	if err != nil {
+		t.Fatal(err)
+	}
+	replicated := &config.Config{}
+	if err := json.Unmarshal(buf, replicated); err != nil {
+		t.Fatal(err)
+	}
+	if replicated.Pulse.ClusterToken != "" || replicated.Plugins != nil {
+		t.Error("expected the local sections to be left out")
+	}
+	if replicated.Hash() != c.Hash() {
+		t.Error("expected the replicated sections to be kept")
+	}
+}
+
+func TestConfigState(t *testing.T) {
+	setupTestPaths()
+	local := DB.MemberList.GetMemberByHostname("node1")
+	member := DB.MemberList.GetMemberByHostname("node2")
+	if state := configState(local); state != "in sync" {
+		t.Errorf("expected the local node to be in sync, got %q", state)
+	}
+	if state := configState(member); state != "" {
+		t.Errorf("expected an unknown state before the member reports a hash, got %q", state)
+	}
+	member.SetConfigHash(DB.Config.Hash())
+	if state := configState(member); state != "in sync" {
+		t.Errorf("expected in sync, got %q", state)
+	}
+	member.SetConfigHash("other")
+	if state := configState(member); state != "drift" {
+		t.Errorf("expected drift, got %q", state)
+	}
+}
+
+func TestReconcileRecordsDrift(t *testing.T) {
+	setupTestPaths()
+	DB.Events = &EventLog{}
+	r := &Replication{}
+	r.Reconcile("node2", DB.Config.Version, "other")
+	r.Reconcile("node2", DB.Config.Version, "other")
+	r.pushes.Wait()
+	if events := DB.Events.List(); len(events) != 1 || events[0].Type != EventConfigDrift {
+		t.Fatalf("expected a single config drift event, got %v", events)
+	}
+	// Back in sync
+	r.Reconcile("node2", DB.Config.Version, DB.Config.Hash())
+	if r.drifted["node2"] {
+		t.Error("expected the drift to be cleared once the member is back in sync")
+	}
+}
+
+func TestLocalRollbackKeepsClusterConfig(t *testing.T) {
+	hostname, err := utils.GetHostname()
+	if err != nil {
+		t.Fatal(err)
+	}
+	location := config.CONFIG_LOCATION
+	config.CONFIG_LOCATION = filepath.Join(t.TempDir(), "config.json")
+	defer func() {
+		config.CONFIG_LOCATION = location
+	}()
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: hostname, IP: "127.0.0.1", Port: "8443"},
+	}, map[string]rpc.MemberStatus_Status{
+		hostname: rpc.MemberStatus_ACTIVE,
+	})
+	DB.Config.Groups = map[string][]string{}
+	DB.Config.Pulse = config.Local{
+		LocalNode:           "a",
+		HealthCheckInterval: 1000,
+		FailOverInterval:    5000,
+		FailOverLimit:       10000,
+		LoggingLevel:        "info",
+	}
+	DB.Config.Version = config.Version{Number: 1, Origin: hostname}
+	if err := DB.Config.Save(); err != nil {
+		t.Fatal(err)
+	}
+	// Make sure the backup has a distinct timestamp
+	time.Sleep(time.Millisecond)
+	DB.Config.Pulse.LoggingLevel = "debug"
+	DB.Config.Groups["group1"] = []string{"10.0.0.10/24"}
+	DB.Config.Version = config.Version{Number: 2, Origin: hostname}
+	if err := DB.Config.Save(); err != nil {
+		t.Fatal(err)
+	}
+	r := &Replication{}
+	if err := r.Rollback(1, false); err != nil {
+		t.Fatal(err)
+	}
+	if DB.Config.Pulse.LoggingLevel != "info" {
+		t.Error("expected our pulseha section to be restored")
+	}
+	if DB.Config.Version.Number != 2 || len(DB.Config.Groups["group1"]) != 1 {
+		t.Errorf("expected the cluster config to be kept, got version %s", DB.Config.Version)
+	}
+	saved, err := config.ReadFile(config.File())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Pulse.LoggingLevel != "info" || saved.Version.Number != 2 {
+		t.Error("expected the restored config to be saved")
+	}
+}
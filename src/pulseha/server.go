@@ -18,8 +18,7 @@ package pulseha
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	log "github.com/sirupsen/logrus"
@@ -30,7 +29,6 @@ import (
 	"github.com/syleron/pulseha/rpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/peer"
 	"io/ioutil"
 	"net"
 	"os"
@@ -48,7 +46,9 @@ type Server struct {
 	sync.Mutex
 	Server      *grpc.Server
 	Listener    net.Listener
-	HCScheduler func()
+	// Listeners for the additional heartbeat addresses of the local node
+	HeartbeatListeners []net.Listener
+	HCScheduler        func()
 }
 
 // Init used to start the bootstrap process
@@ -90,29 +90,22 @@ func (s *Server) Setup() {
 		log.Fatal(err.Error())
 		return
 	}
-	// load member cert/key
-	peerCert, err := tls.LoadX509KeyPair(security.CertDir+"pulseha.crt", security.CertDir+"pulseha.key")
-	if err != nil {
-		log.Fatalf("load peer cert/key error:%v", err)
-		return
+	// Make sure our certificate identifies us to our peers
+	if err := security.CheckPeerCert(hostname); err != nil && security.External() {
+		log.Error("TLS certificate is not valid for " + hostname + ": " + err.Error())
+	} else if err != nil {
+		log.Warn("TLS certificate is not valid for " + hostname + " (" + err.Error() + "). Regenerating..")
+		if err := security.GenTLSKeys(DB.Config.LocalNode().IP, hostname); err != nil {
+			log.Error("Unable to regenerate TLS certificate: " + err.Error())
+		}
 	}
-	// Load CA cert
-	caCert, err := ioutil.ReadFile(security.CertDir + "ca.crt")
+	// Load our certificate and the cluster CA client certificates are verified against
+	tlsConfig, err := security.ServerTLSConfig()
 	if err != nil {
-		log.Fatalf("read ca cert file error:%v", err)
-		return
-	}
-	// Define cert pool
-	caCertPool := x509.NewCertPool()
-	if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
-		log.Fatal("failed to append certs")
+		log.Fatalf("load peer cert/key error:%v", err)
 		return
 	}
-	creds := credentials.NewTLS(&tls.Config{
-		InsecureSkipVerify: true,
-		Certificates:       []tls.Certificate{peerCert},
-		ClientCAs:          caCertPool,
-	})
+	creds := credentials.NewTLS(tlsConfig)
 	s.Server = grpc.NewServer(
 		grpc.Creds(creds),
 		grpc.UnaryInterceptor(s.serverInterceptor),
@@ -121,10 +114,28 @@ func (s *Server) Setup() {
 	rpc.RegisterServerServer(s.Server, s)
 	// Set our start delay
 	DB.StartDelay = true
+	// Start our UDP heartbeat transport
+	if err := DB.Transport.Start(s.udpHealthCheck); err != nil {
+		log.Error("Unable to start the UDP heartbeat transport. Health checks will be sent over gRPC: " + err.Error())
+	}
 	// Setup our members
 	DB.MemberList.Setup()
+	// Catch up with any config changes made while we were offline
+	go DB.Replication.PullNewest()
 	// Start PulseHA daemon server
 	log.Info("PulseHA initialised on " + DB.Config.LocalNode().IP + ":" + DB.Config.LocalNode().Port)
+	// Serve our additional heartbeat addresses
+	localNode := DB.Config.LocalNode()
+	for _, address := range localNode.HeartbeatAddresses {
+		l, err := net.Listen("tcp", address)
+		if err != nil {
+			log.Error("Unable to listen on heartbeat address " + address + ": " + err.Error())
+			continue
+		}
+		s.HeartbeatListeners = append(s.HeartbeatListeners, l)
+		log.Info("PulseHA heartbeat path listening on " + address)
+		go s.Server.Serve(l)
+	}
 	if err := s.Server.Serve(s.Listener); err != nil {
 		log.Fatalf("grpc serve error: %s", err)
 	}
@@ -142,12 +153,9 @@ func (s *Server) serverInterceptor(ctx context.Context,
 
 	// Skip authorize when join is requested
 	if info.FullMethod != "/proto.Server/Join" {
-		peer, ok := peer.FromContext(ctx)
-		if ok {
-			tlsInfo := peer.AuthInfo.(credentials.TLSInfo)
-			if tlsInfo.SecurityLevel != credentials.PrivacyAndIntegrity {
-				return nil, errors.New("invalid permissions")
-			}
+		if _, err := peerCertificate(ctx); err != nil {
+			DB.Logging.Warn("Rejected " + info.FullMethod + " request: " + err.Error())
+			return nil, errors.New("invalid permissions")
 		}
 	}
 
@@ -163,10 +171,14 @@ func (s *Server) Shutdown() {
 	log.Info("Shutting down PulseHA daemon")
 	// Make passive
 	if DB.Config.ClusterCheck() {
-		MakeLocalPassive()
+		releaseLocalGroups(true)
 	}
+	// Stop all of our background tasks
+	DB.Supervisor.StopAll(context.Background())
 	// Clear our
 	DB.MemberList.Reset()
+	// Stop our UDP heartbeat transport
+	DB.Transport.Stop()
 	// Shutdown our RPC server
 	if s.Server != nil {
 		s.Server.Stop()
@@ -174,6 +186,10 @@ func (s *Server) Shutdown() {
 	if s.Listener != nil {
 		s.Listener.Close()
 	}
+	for _, l := range s.HeartbeatListeners {
+		l.Close()
+	}
+	s.HeartbeatListeners = nil
 }
 
 // HealthCheck command used to receive the main RPC health check.
@@ -181,11 +197,37 @@ func (s *Server) HealthCheck(ctx context.Context, in *rpc.HealthCheckRequest) (*
 	DB.Logging.Debug("Server:HealthCheck() Receiving health check")
 	s.Lock()
 	defer s.Unlock()
-	if !CanCommunicate(ctx) {
+	if !CanCommunicateAs(ctx, in.Hostname) {
 		return &rpc.HealthCheckResponse{}, errors.New(language.CLUSTER_UNATHORIZED)
 	}
+	return s.healthCheck(in), nil
+}
+
+// udpHealthCheck handles a health check received over the UDP heartbeat transport.
+func (s *Server) udpHealthCheck(in *rpc.HealthCheckRequest) *rpc.HealthCheckResponse {
+	DB.Logging.Debug("Server:udpHealthCheck() Receiving health check")
+	s.Lock()
+	defer s.Unlock()
+	return s.healthCheck(in)
+}
+
+// healthCheck acts on a health check received from the active member.
+// Note: The caller must hold the server lock.
+func (s *Server) healthCheck(in *rpc.HealthCheckRequest) *rpc.HealthCheckResponse {
 	activeHostname, _ := DB.MemberList.GetActiveMember()
 	localMember, _ := DB.MemberList.GetLocalMember()
+	// Ignore health checks from an active member of an older election term.
+	// Note: Our term is returned so the sender knows to step down.
+	localTerm := DB.Election.GetTerm()
+	if !DB.Election.ObserveLeader(in.Term, in.Hostname) {
+		DB.Logging.Warn("Ignoring health check from " + in.Hostname + " as it belongs to an older election term")
+		return healthCheckResponse(localMember)
+	}
+	// Make sure only one member claims to be active.
+	// Note: Our term from before this health check is used as we may have just adopted a newer one.
+	if winner, conflict := DB.SplitBrain.Observe(claimFromHealthCheck(in), localClaim(localMember, localTerm)); conflict {
+		return s.resolveSplitBrain(in, localMember, winner)
+	}
 	if activeHostname != localMember.Hostname {
 		localMember := DB.MemberList.GetMemberByHostname(localMember.Hostname)
 		// make passive to reset the networking
@@ -195,21 +237,112 @@ func (s *Server) HealthCheck(ctx context.Context, in *rpc.HealthCheckRequest) (*
 		//	//localMember.MakePassive()
 		//}
 		localMember.SetLastHCResponse(time.Now())
+		DB.Detector.Heartbeat()
 		DB.MemberList.Update(in.Memberlist)
-	} else {
-		DB.Logging.Warn("Active node mismatch")
-		hostname := GetFailOverCountWinner(in.Memberlist)
-		DB.Logging.Info("Member " + hostname + " has been determined as the correct active node.")
-		if hostname != localMember.Hostname {
-			if err := localMember.MakePassive(); err != nil {
-				DB.Logging.Error(err.Error())
-			}
-		} else {
-			localMember.SetLastHCResponse(time.Time{})
-		}
+		// Bring up or down any groups placed on us
+		reconcileLocalGroups(false)
+		// Pull the config of the active if it is newer
+		// Note: The active re-syncs us if our config has drifted.
+		DB.Replication.Observe(in.Hostname, versionFromRPC(in.ConfigVersion))
 	}
+	return healthCheckResponse(localMember)
+}
+
+// healthCheckResponse creates the response to a health check.
+func healthCheckResponse(localMember *Member) *rpc.HealthCheckResponse {
 	return &rpc.HealthCheckResponse{
-		Score: int32(localMember.Score),
+		Score:         int32(localMember.GetScore()),
+		Term:          DB.Election.GetTerm(),
+		ConfigVersion: versionToRPC(DB.Config.Version),
+		ConfigHash:    DB.Config.Hash(),
+	}
+}
+
+// resolveSplitBrain acts on a health check received while more than one member claims to be active.
+// The local member steps down if it lost. Passive members only follow the winner.
+// Note: The caller must hold the server lock.
+func (s *Server) resolveSplitBrain(in *rpc.HealthCheckRequest, localMember *Member, winner Claim) *rpc.HealthCheckResponse {
+	if localMember.GetStatus() == rpc.MemberStatus_ACTIVE && winner.Hostname != localMember.GetHostname() {
+		DB.Logging.Warn("Split brain lost to " + winner.Hostname + ". Stepping down..")
+		if err := localMember.MakePassive(context.Background()); err != nil {
+			DB.Logging.Error(err.Error())
+		}
+		DB.SplitBrain.Forget(localMember.GetHostname())
+	}
+	if in.Hostname == winner.Hostname {
+		localMember.SetLastHCResponse(time.Now())
+		DB.Detector.Heartbeat()
+		DB.MemberList.Update(in.Memberlist)
+		reconcileLocalGroups(false)
+		DB.Replication.Observe(in.Hostname, versionFromRPC(in.ConfigVersion))
+	} else {
+		DB.Logging.Debug("Server:HealthCheck() Ignoring member list from " + in.Hostname + " as it lost the split brain")
+	}
+	return healthCheckResponse(localMember)
+}
+
+// Vote command used to request our vote for a candidate wishing to become active.
+func (s *Server) Vote(ctx context.Context, in *rpc.VoteRequest) (*rpc.VoteResponse, error) {
+	DB.Logging.Debug("Server:Vote() Vote requested by " + in.Candidate + " for term " + strconv.FormatUint(in.Term, 10))
+	s.Lock()
+	defer s.Unlock()
+	if !CanCommunicateAs(ctx, in.Candidate) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	if DB.MemberList.GetMemberByHostname(in.Candidate) == nil {
+		return &rpc.VoteResponse{
+			Success: false,
+			Message: "candidate is not a member of the cluster",
+			Term:    DB.Election.GetTerm(),
+		}, nil
+	}
+	// Monitoring nodes and nodes in maintenance never become active
+	if !nodeIsElectable(in.Candidate) {
+		DB.Logging.Info("Refused vote for " + in.Candidate + " as it is a monitoring node or in maintenance")
+		return &rpc.VoteResponse{
+			Success: true,
+			Granted: false,
+			Message: "candidate is a monitoring node or in maintenance",
+			Term:    DB.Election.GetTerm(),
+		}, nil
+	}
+	localMember, err := DB.MemberList.GetLocalMember()
+	if err != nil {
+		return &rpc.VoteResponse{
+			Success: false,
+			Message: err.Error(),
+			Term:    DB.Election.GetTerm(),
+		}, nil
+	}
+	// Refuse while we are the active or are still receiving health checks from one.
+	// Note: The candidate's term is not adopted as that would make the active step down.
+	hcLimit := time.Duration(DB.Config.Pulse.FailOverLimit) * time.Millisecond
+	if localMember.GetStatus() == rpc.MemberStatus_ACTIVE ||
+		time.Since(localMember.GetLastHCResponse()) < hcLimit {
+		DB.Logging.Info("Refused vote for " + in.Candidate + " as the active member is still available")
+		return &rpc.VoteResponse{
+			Success: true,
+			Granted: false,
+			Term:    DB.Election.GetTerm(),
+		}, nil
+	}
+	// A pre-vote only tells the candidate whether it would win
+	if in.PreVote {
+		granted, term := DB.Election.WouldGrant(in.Term, in.Candidate)
+		return &rpc.VoteResponse{
+			Success: true,
+			Granted: granted,
+			Term:    term,
+		}, nil
+	}
+	granted, term := DB.Election.Grant(in.Term, in.Candidate)
+	if granted {
+		DB.Logging.Info("Granted vote to " + in.Candidate + " for term " + strconv.FormatUint(term, 10))
+	}
+	return &rpc.VoteResponse{
+		Success: true,
+		Granted: granted,
+		Term:    term,
 	}, nil
 }
 
@@ -247,6 +380,59 @@ func (s *Server) Join(ctx context.Context, in *rpc.JoinRequest) (*rpc.JoinRespon
 				Message: "unable to join cluster as a node with id " + in.Uid + " already exists!",
 			}, nil
 		}
+		if _, _, err := nodeGetByHostname(originNode.Hostname); err == nil {
+			return &rpc.JoinResponse{
+				Success: false,
+				Message: "unable to join cluster as a node with hostname " + originNode.Hostname + " already exists!",
+			}, nil
+		}
+		// The joining node must use the same CA as us
+		if security.External() && len(in.Csr) > 0 {
+			return &rpc.JoinResponse{
+				Success: false,
+				Message: "Unable to join as the cluster certificates are issued by an external CA. Please set tls_external_ca on the joining node.",
+			}, nil
+		}
+		// Older versions of PulseHA expect to be sent our CA key
+		if !security.External() && len(in.Csr) == 0 {
+			return &rpc.JoinResponse{
+				Success: false,
+				Message: "Unable to join as the joining node must send a certificate signing request. Please upgrade PulseHA on the joining node.",
+			}, nil
+		}
+		var caCert, crl, crt []byte
+		// Certificates issued by an external CA are never distributed
+		if !security.External() {
+			// Attempt to read our CA details
+			caCert, err = ioutil.ReadFile(security.CertDir + "ca.crt")
+			if err != nil {
+				DB.Logging.Error("Unable to load ca.crt: " + err.Error())
+				return &rpc.JoinResponse{
+					Success: false,
+					Message: "Unable to gather TLS details to join the cluster",
+				}, nil
+			}
+			// Send our revocation list so the joining node rejects revoked members
+			crl, err = ioutil.ReadFile(security.CertDir + security.CRLName)
+			if err != nil && !os.IsNotExist(err) {
+				DB.Logging.Error("Unable to load " + security.CRLName + ": " + err.Error())
+				return &rpc.JoinResponse{
+					Success: false,
+					Message: "Unable to gather TLS details to join the cluster",
+				}, nil
+			}
+			// Sign the joining node's certificate before it becomes a member
+			crt, err = signCertificate(in.Csr, originNode.IP, originNode.Hostname, DB.Config.Pulse.ClusterToken)
+			if err != nil {
+				DB.Logging.Warn("Unable to sign the certificate for " + originNode.Hostname + ": " + err.Error())
+				return &rpc.JoinResponse{
+					Success: false,
+					Message: "Unable to sign certificate: " + err.Error(),
+				}, nil
+			}
+		}
+		// The joining node receives the heartbeat key with our config
+		ensureHeartbeatKey()
 		// TODO: Node validation?
 		// Add node to config
 		if err = nodeAdd(in.Uid, originNode); err != nil {
@@ -273,7 +459,7 @@ func (s *Server) Join(ctx context.Context, in *rpc.JoinRequest) (*rpc.JoinRespon
 		// Add node to the memberlist
 		DB.MemberList.Reload()
 		// Return with our new updated config
-		buf, err := json.Marshal(DB.Config)
+		buf, err := DB.Config.MarshalReplicated()
 		// Handle failure to marshal config
 		if err != nil {
 			log.Fatalf("Unable to marshal config: %s", err)
@@ -282,40 +468,14 @@ func (s *Server) Join(ctx context.Context, in *rpc.JoinRequest) (*rpc.JoinRespon
 				Message: err.Error(),
 			}, nil
 		}
-		// Attempt to read our CA details
-		caCert, err := ioutil.ReadFile(security.CertDir + "ca.crt")
-		if err != nil {
-			log.Fatalf("Unable to load ca.crt: %s", err)
-			return &rpc.JoinResponse{
-				Success: false,
-				Message: err.Error(),
-			}, nil
-		}
-		// Make sure our CA cert files exist
-		if !utils.CheckFileExists(security.CertDir+"ca.crt") ||
-			!utils.CheckFileExists(security.CertDir+"ca.key") {
-			log.Fatal("ca.crt and or ca.key does not exists")
-			return &rpc.JoinResponse{
-				Success: false,
-				Message: "Unable to gather TLS details to join the cluster",
-			}, nil
-		}
-		// Load our cert details
-		caKey, err := ioutil.ReadFile(security.CertDir + "ca.key")
-		if err != nil {
-			log.Fatalf("Unable to load ca.key: %s", err)
-			return &rpc.JoinResponse{
-				Success: false,
-				Message: err.Error(),
-			}, nil
-		}
 		DB.Logging.Info(in.Uid + " has joined the cluster")
 		return &rpc.JoinResponse{
 			Success: true,
 			Message: "Successfully added ",
 			Config:  buf,
 			CaCrt:   string(caCert),
-			CaKey:   string(caKey),
+			Crt:     string(crt),
+			Crl:     string(crl),
 		}, nil
 	}
 	return &rpc.JoinResponse{
@@ -372,7 +532,18 @@ func (s *Server) Remove(ctx context.Context, in *rpc.RemoveRequest) (*rpc.Remove
 	}
 	// Set our member status
 	member := DB.MemberList.GetMemberByHostname(in.Hostname)
-	member.SetStatus(rpc.MemberStatus_LEAVING)
+	if member == nil {
+		return &rpc.RemoveResponse{
+			Success: false,
+			Message: in.Hostname + " is not a member of the cluster",
+		}, nil
+	}
+	if err := member.SetStatus(rpc.MemberStatus_LEAVING); err != nil {
+		return &rpc.RemoveResponse{
+			Success: false,
+			Message: "Unable to remove " + in.Hostname + ": " + err.Error(),
+		}, nil
+	}
 	if in.Hostname == localHostname {
 		s.Shutdown()
 		nodesClearLocal()
@@ -417,21 +588,111 @@ func (s *Server) ConfigSync(ctx context.Context, in *rpc.ConfigSyncRequest) (*rp
 			Message: err.Error(),
 		}, nil
 	}
-	// !!!IMPORTANT!!!: Do not replace our local config
-	newConfig.Pulse = DB.Config.Pulse
-	// !!!IMPORTANT!!!: Do not replace our plugins config
-	newConfig.Plugins = DB.Config.Plugins
-	// Set our new config in memory
-	DB.SetConfig(newConfig)
-	// Save our config to file
-	DB.Config.Save()
-	// Update our member list
-	DB.MemberList.Reload()
+	// Replace our config unless ours is newer
+	if err := DB.Replication.Apply(newConfig); err != nil {
+		DB.Logging.Warn(err.Error())
+		return &rpc.ConfigSyncResponse{
+			Success: false,
+			Message: err.Error(),
+			Version: versionToRPC(DB.Config.Version),
+		}, nil
+	}
 	// Let the logs know
 	DB.Logging.Debug("Successfully r-synced local config")
 	// Return with yay
 	return &rpc.ConfigSyncResponse{
 		Success: true,
+		Version: versionToRPC(DB.Config.Version),
+	}, nil
+}
+
+// ConfigVersion command used to get our config version and optionally our config.
+func (s *Server) ConfigVersion(ctx context.Context, in *rpc.ConfigVersionRequest) (*rpc.ConfigVersionResponse, error) {
+	DB.Logging.Debug("Server:ConfigVersion() Config version requested")
+	if !CanCommunicate(ctx) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return &rpc.ConfigVersionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	resp := &rpc.ConfigVersionResponse{
+		Success:  true,
+		Hostname: localNode.Hostname,
+		Version:  versionToRPC(DB.Config.Version),
+	}
+	if in.Config {
+		buf, err := DB.Config.MarshalReplicated()
+		if err != nil {
+			return &rpc.ConfigVersionResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		resp.Config = buf
+	}
+	return resp, nil
+}
+
+// SignCertificate command used to sign a member certificate with our cluster CA key.
+func (s *Server) SignCertificate(ctx context.Context, in *rpc.SignCertificateRequest) (*rpc.SignCertificateResponse, error) {
+	DB.Logging.Debug("Server:SignCertificate() Certificate requested for " + in.Hostname)
+	if !CanCommunicate(ctx) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	// Members may only renew their own certificate
+	if _, _, err := nodeGetByHostname(in.Hostname); err == nil {
+		if !CanCommunicateAs(ctx, in.Hostname) {
+			return nil, errors.New(language.CLUSTER_UNATHORIZED)
+		}
+	} else if in.TokenHash == "" || subtle.ConstantTimeCompare([]byte(in.TokenHash), []byte(DB.Config.Pulse.ClusterToken)) != 1 {
+		// Certificates for new members are only signed for joins made with our cluster token
+		DB.Logging.Warn("Refused to sign a certificate for " + in.Hostname + " without a valid cluster token")
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	crt, err := security.SignCSR(in.Csr, in.Ip, in.Hostname)
+	if err != nil {
+		return &rpc.SignCertificateResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	DB.Logging.Info("Signed certificate for " + in.Hostname)
+	return &rpc.SignCertificateResponse{
+		Success: true,
+		Crt:     string(crt),
+	}, nil
+}
+
+// CertificateStatus command used to describe our certificate and the CAs we trust.
+func (s *Server) CertificateStatus(ctx context.Context, in *rpc.CertificateStatusRequest) (*rpc.CertificateStatusResponse, error) {
+	DB.Logging.Debug("Server:CertificateStatus() Certificate status requested")
+	if !CanCommunicate(ctx) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	return certificateStatus(), nil
+}
+
+// CertUpdate command used to update the CAs we trust, our revocation list or to renew our certificate.
+func (s *Server) CertUpdate(ctx context.Context, in *rpc.CertUpdateRequest) (*rpc.CertUpdateResponse, error) {
+	DB.Logging.Debug("Server:CertUpdate() Certificate update received")
+	s.Lock()
+	defer s.Unlock()
+	if !CanCommunicate(ctx) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	if err := applyCertUpdate(in); err != nil {
+		DB.Logging.Warn("Unable to apply certificate update: " + err.Error())
+		return &rpc.CertUpdateResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &rpc.CertUpdateResponse{
+		Success: true,
 	}, nil
 }
 
@@ -460,9 +721,10 @@ func (s *Server) Promote(ctx context.Context, in *rpc.PromoteRequest) (*rpc.Prom
 			Success: false,
 		}, nil
 	}
-	if err := member.MakeActive(); err != nil {
+	if err := member.MakeActive(ctx); err != nil {
 		return &rpc.PromoteResponse{
 			Success: false,
+			Message: err.Error(),
 		}, nil
 	}
 	DB.Logging.Info(in.Member + " has been promoted to active")
@@ -496,10 +758,15 @@ func (s *Server) MakePassive(ctx context.Context, in *rpc.MakePassiveRequest) (*
 			Success: false,
 		}, nil
 	}
-	err = member.MakePassive()
+	if err := member.MakePassive(ctx); err != nil {
+		return &rpc.MakePassiveResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
 	DB.Logging.Info(in.Member + " has been demoted to passive")
 	return &rpc.MakePassiveResponse{
-		Success: err == nil,
+		Success: true,
 	}, nil
 }
 
@@ -565,3 +832,68 @@ func (s *Server) Logs(ctx context.Context, in *rpc.LogsRequest) (*rpc.LogsRespon
 func (s *Server) Describe(ctx context.Context, in *rpc.DescribeRequest) (*rpc.DescribeResponse, error) {
 	return &rpc.DescribeResponse{}, nil
 }
+
+// Preflight command used to check that the local node is ready to become active.
+func (s *Server) Preflight(ctx context.Context, in *rpc.PreflightRequest) (*rpc.PreflightResponse, error) {
+	DB.Logging.Debug("Server:Preflight() Checking the local node is ready to become active")
+	s.Lock()
+	defer s.Unlock()
+	if !CanCommunicate(ctx) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	if !isLocalMember(in.Member) {
+		return &rpc.PreflightResponse{
+			Success: false,
+			Message: in.Member + " is not the local node",
+		}, nil
+	}
+	if err := preflightLocal(); err != nil {
+		return &rpc.PreflightResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	member := DB.MemberList.GetMemberByHostname(in.Member)
+	if member == nil {
+		return &rpc.PreflightResponse{
+			Success: false,
+			Message: language.CLUSTER_NODE_NOTFOUND,
+		}, nil
+	}
+	return &rpc.PreflightResponse{
+		Success: true,
+		Score:   int32(member.GetScore()),
+	}, nil
+}
+
+// GroupStatus command used to list the floating IP groups up on the local node.
+func (s *Server) GroupStatus(ctx context.Context, in *rpc.GroupStatusRequest) (*rpc.GroupStatusResponse, error) {
+	DB.Logging.Debug("Server:GroupStatus() Listing the floating IP groups held")
+	s.Lock()
+	defer s.Unlock()
+	if !CanCommunicate(ctx) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	return &rpc.GroupStatusResponse{
+		Success: true,
+		Groups:  localHeldGroups(in.Announce),
+	}, nil
+}
+
+// Heartbeat command used to exchange mesh heartbeats.
+// Note: The server lock isn't taken so heartbeats are never delayed by other commands.
+func (s *Server) Heartbeat(ctx context.Context, in *rpc.HeartbeatRequest) (*rpc.HeartbeatResponse, error) {
+	DB.Logging.Debug("Server:Heartbeat() Receiving heartbeat from " + in.Hostname)
+	if !CanCommunicateAs(ctx, in.Hostname) {
+		return nil, errors.New(language.CLUSTER_UNATHORIZED)
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return nil, err
+	}
+	DB.Mesh.RecordRow(in.Hostname, in.Reachable)
+	return &rpc.HeartbeatResponse{
+		Hostname:  localNode.Hostname,
+		Reachable: DB.Mesh.Reachable(),
+	}, nil
+}
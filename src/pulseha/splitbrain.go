@@ -0,0 +1,177 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/rpc"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Claim defines a member claiming to be active.
+type Claim struct {
+	Hostname string
+	// The election term the member was elected in
+	Term uint64
+	// The configured failover priority of the member
+	Priority int
+	// The health check score of the member
+	Score int
+	// When the member became active
+	ActiveSince time.Time
+	// When the claim was last seen
+	seen time.Time
+}
+
+// beats determines whether a claim wins over another claim.
+// The newest term wins, then the highest priority, then the highest score and then the member
+// that has been active the longest. The hostname is used as a final tie breaker.
+func (c Claim) beats(o Claim) bool {
+	if c.Term != o.Term {
+		return c.Term > o.Term
+	}
+	if c.Priority != o.Priority {
+		return c.Priority > o.Priority
+	}
+	if c.Score != o.Score {
+		return c.Score > o.Score
+	}
+	if !c.ActiveSince.Equal(o.ActiveSince) {
+		// A member that doesn't know when it became active loses
+		if c.ActiveSince.IsZero() || o.ActiveSince.IsZero() {
+			return o.ActiveSince.IsZero()
+		}
+		return c.ActiveSince.Before(o.ActiveSince)
+	}
+	return c.Hostname < o.Hostname
+}
+
+// resolveSplitBrain returns the claim every member agrees should remain active.
+func resolveSplitBrain(claims []Claim) Claim {
+	var winner Claim
+	for i, claim := range claims {
+		if i == 0 || claim.beats(winner) {
+			winner = claim
+		}
+	}
+	return winner
+}
+
+// SplitBrain detects when more than one member claims to be active.
+// Claims are gathered from the health checks we receive and expire after the failover limit.
+type SplitBrain struct {
+	claims map[string]Claim
+	// The winner of the split brain currently being resolved
+	winner string
+	sync.Mutex
+}
+
+// Observe records a claim and returns the winner when more than one member claims to be active.
+// The local claim, if any, is included when the local member is active.
+func (s *SplitBrain) Observe(claim Claim, local *Claim) (Claim, bool) {
+	s.Lock()
+	defer s.Unlock()
+	if s.claims == nil {
+		s.claims = map[string]Claim{}
+	}
+	now := time.Now()
+	claim.seen = now
+	s.claims[claim.Hostname] = claim
+	if local != nil {
+		local.seen = now
+		s.claims[local.Hostname] = *local
+	} else if localNode, err := DB.Config.GetLocalNode(); err == nil {
+		delete(s.claims, localNode.Hostname)
+	}
+	// Forget claims we haven't seen recently and claims from older terms.
+	// Note: A member elected in a newer term has taken over from the members of older terms.
+	expiry := time.Duration(DB.Config.Pulse.FailOverLimit) * time.Millisecond
+	claims := []Claim{}
+	for hostname, c := range s.claims {
+		stale := c.Term < claim.Term && (local == nil || hostname != local.Hostname)
+		if stale || now.Sub(c.seen) > expiry {
+			delete(s.claims, hostname)
+			continue
+		}
+		claims = append(claims, c)
+	}
+	if len(claims) < 2 {
+		s.winner = ""
+		return claim, false
+	}
+	winner := resolveSplitBrain(claims)
+	// Record the split brain once for each winner
+	if winner.Hostname != s.winner {
+		s.winner = winner.Hostname
+		hostnames := []string{}
+		for _, c := range claims {
+			hostnames = append(hostnames, c.Hostname)
+		}
+		sort.Strings(hostnames)
+		message := "Split brain detected between " + strings.Join(hostnames, ", ") +
+			". " + winner.Hostname + " remains active"
+		DB.Logging.Warn(message)
+		if DB.Events != nil {
+			DB.Events.Record(EventSplitBrain, winner.Hostname, message)
+		}
+	}
+	return winner, true
+}
+
+// Forget removes the claim of a member that is no longer active.
+func (s *SplitBrain) Forget(hostname string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.claims, hostname)
+}
+
+// newClaim creates a claim for a member with the details we know about it.
+func newClaim(hostname string, term uint64, score int, activeSince time.Time) Claim {
+	claim := Claim{
+		Hostname:    hostname,
+		Term:        term,
+		Score:       score,
+		ActiveSince: activeSince,
+	}
+	if _, node, err := nodeGetByHostname(hostname); err == nil {
+		claim.Priority = node.Priority
+	}
+	return claim
+}
+
+// claimFromHealthCheck creates a claim for the active member that sent a health check.
+func claimFromHealthCheck(in *rpc.HealthCheckRequest) Claim {
+	var score int
+	for _, member := range in.Memberlist {
+		if member.Hostname == in.Hostname {
+			score = int(member.Score)
+		}
+	}
+	activeSince, _ := time.Parse(time.RFC3339Nano, in.ActiveTime)
+	return newClaim(in.Hostname, in.Term, score, activeSince)
+}
+
+// localClaim creates a claim for the local member if it is active.
+func localClaim(member *Member, term uint64) *Claim {
+	if member.GetStatus() != rpc.MemberStatus_ACTIVE {
+		return nil
+	}
+	claim := newClaim(member.GetHostname(), term, member.GetScore(), member.GetActiveSince())
+	return &claim
+}
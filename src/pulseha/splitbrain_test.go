@@ -0,0 +1,121 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"testing"
+	"time"
+)
+
+func TestResolveSplitBrain(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name   string
+		claims []Claim
+		want   string
+	}{
+		{"term", []Claim{
+			{Hostname: "node1", Term: 2, Priority: 10, Score: 10},
+			{Hostname: "node2", Term: 3},
+		}, "node2"},
+		{"priority", []Claim{
+			{Hostname: "node1", Term: 3, Score: 10},
+			{Hostname: "node2", Term: 3, Priority: 1},
+			{Hostname: "node3", Term: 3},
+		}, "node2"},
+		{"score", []Claim{
+			{Hostname: "node1", Term: 3, Score: 5},
+			{Hostname: "node2", Term: 3, Score: 10},
+		}, "node2"},
+		{"uptime", []Claim{
+			{Hostname: "node1", Term: 3, ActiveSince: now},
+			{Hostname: "node2", Term: 3, ActiveSince: now.Add(-time.Minute)},
+			{Hostname: "node3", Term: 3},
+		}, "node2"},
+		{"hostname", []Claim{
+			{Hostname: "node2", Term: 3},
+			{Hostname: "node1", Term: 3},
+		}, "node1"},
+	}
+	for _, c := range cases {
+		if got := resolveSplitBrain(c.claims).Hostname; got != c.want {
+			t.Errorf("%s: expected %s to win, got %s", c.name, c.want, got)
+		}
+		// The order claims are seen in must not matter
+		reversed := make([]Claim, len(c.claims))
+		for i, claim := range c.claims {
+			reversed[len(c.claims)-1-i] = claim
+		}
+		if got := resolveSplitBrain(reversed).Hostname; got != c.want {
+			t.Errorf("%s (reversed): expected %s to win, got %s", c.name, c.want, got)
+		}
+	}
+}
+
+func TestSplitBrainObserve(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2", Priority: 5},
+		"c": {Hostname: "node3"},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_ACTIVE,
+		"node3": rpc.MemberStatus_ACTIVE,
+	})
+	DB.Config.Pulse.LocalNode = "a"
+	DB.Config.Pulse.FailOverLimit = 10000
+	DB.Events = &EventLog{}
+	s := &SplitBrain{}
+	if _, conflict := s.Observe(newClaim("node3", 4, 0, time.Time{}), nil); conflict {
+		t.Fatal("expected no conflict with a single claim")
+	}
+	winner, conflict := s.Observe(newClaim("node2", 4, 0, time.Time{}), nil)
+	if !conflict {
+		t.Fatal("expected a conflict with two claims")
+	}
+	if winner.Hostname != "node2" {
+		t.Errorf("expected node2 to win on priority, got %s", winner.Hostname)
+	}
+	s.Observe(newClaim("node3", 4, 0, time.Time{}), nil)
+	if events := DB.Events.List(); len(events) != 1 || events[0].Type != EventSplitBrain {
+		t.Errorf("expected a single split brain event, got %v", events)
+	}
+	// A member elected in a newer term takes over from the members of older terms
+	if _, conflict := s.Observe(newClaim("node3", 5, 0, time.Time{}), nil); conflict {
+		t.Error("expected claims from older terms to be forgotten")
+	}
+}
+
+func TestSplitBrainObserveLocalClaim(t *testing.T) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1"},
+		"b": {Hostname: "node2"},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_ACTIVE,
+	})
+	DB.Config.Pulse.LocalNode = "a"
+	DB.Config.Pulse.FailOverLimit = 10000
+	s := &SplitBrain{}
+	local := DB.MemberList.GetMemberByHostname("node1")
+	winner, conflict := s.Observe(newClaim("node2", 3, 0, time.Time{}), localClaim(local, 2))
+	if !conflict || winner.Hostname != "node2" {
+		t.Errorf("expected node2 to win with a newer term, got %s (conflict %v)", winner.Hostname, conflict)
+	}
+}
@@ -0,0 +1,156 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"github.com/syleron/pulseha/rpc"
+	"sync"
+	"time"
+)
+
+// memberTransitions defines the states a member may move to from each state.
+// Note: Moving to the state a member is already in is always allowed and is a no-op.
+var memberTransitions = map[rpc.MemberStatus_Status][]rpc.MemberStatus_Status{
+	// A member we have not heard from since being loaded
+	rpc.MemberStatus_UNCONFIGURED: {
+		rpc.MemberStatus_UNAVAILABLE,
+		rpc.MemberStatus_PASSIVE,
+		rpc.MemberStatus_ACTIVE,
+		rpc.MemberStatus_MONITORING,
+		rpc.MemberStatus_MAINTENANCE,
+		rpc.MemberStatus_LEAVING,
+	},
+	rpc.MemberStatus_UNAVAILABLE: {
+		rpc.MemberStatus_PASSIVE,
+		rpc.MemberStatus_ACTIVE,
+		rpc.MemberStatus_MONITORING,
+		rpc.MemberStatus_MAINTENANCE,
+		rpc.MemberStatus_LEAVING,
+	},
+	rpc.MemberStatus_PASSIVE: {
+		rpc.MemberStatus_ACTIVE,
+		rpc.MemberStatus_SUSPICIOUS,
+		rpc.MemberStatus_UNAVAILABLE,
+		rpc.MemberStatus_MONITORING,
+		rpc.MemberStatus_MAINTENANCE,
+		rpc.MemberStatus_LEAVING,
+	},
+	rpc.MemberStatus_ACTIVE: {
+		rpc.MemberStatus_PASSIVE,
+		rpc.MemberStatus_SUSPICIOUS,
+		rpc.MemberStatus_UNAVAILABLE,
+		rpc.MemberStatus_MAINTENANCE,
+		rpc.MemberStatus_LEAVING,
+	},
+	rpc.MemberStatus_SUSPICIOUS: {
+		rpc.MemberStatus_ACTIVE,
+		rpc.MemberStatus_PASSIVE,
+		rpc.MemberStatus_UNAVAILABLE,
+		rpc.MemberStatus_MONITORING,
+		rpc.MemberStatus_MAINTENANCE,
+		rpc.MemberStatus_LEAVING,
+	},
+	// A monitoring member must never become active directly
+	rpc.MemberStatus_MONITORING: {
+		rpc.MemberStatus_PASSIVE,
+		rpc.MemberStatus_SUSPICIOUS,
+		rpc.MemberStatus_UNAVAILABLE,
+		rpc.MemberStatus_MAINTENANCE,
+		rpc.MemberStatus_LEAVING,
+	},
+	// A member in maintenance must be taken out of maintenance before it can become active
+	rpc.MemberStatus_MAINTENANCE: {
+		rpc.MemberStatus_PASSIVE,
+		rpc.MemberStatus_MONITORING,
+		rpc.MemberStatus_UNAVAILABLE,
+		rpc.MemberStatus_LEAVING,
+	},
+	// A leaving member is either removed or reset
+	rpc.MemberStatus_LEAVING: {
+		rpc.MemberStatus_UNCONFIGURED,
+		rpc.MemberStatus_UNAVAILABLE,
+	},
+}
+
+// ValidTransition returns whether a member may move from one state to another.
+func ValidTransition(from, to rpc.MemberStatus_Status) bool {
+	if from == to {
+		return true
+	}
+	for _, status := range memberTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition defines a single state change of a member.
+type Transition struct {
+	Hostname string
+	From     rpc.MemberStatus_Status
+	To       rpc.MemberStatus_Status
+	Time     time.Time
+}
+
+// TransitionHook is called after a member has changed state.
+type TransitionHook func(t Transition)
+
+// StateMachine defines our member state machine object.
+// It holds the hooks run on every member state change.
+type StateMachine struct {
+	hooks []TransitionHook
+	sync.Mutex
+}
+
+// AddHook registers a hook to run on every transition.
+func (s *StateMachine) AddHook(hook TransitionHook) {
+	s.Lock()
+	defer s.Unlock()
+	s.hooks = append(s.hooks, hook)
+}
+
+// Fire runs our built in and registered hooks for a transition.
+func (s *StateMachine) Fire(t Transition) {
+	recordTransition(t)
+	informTransition()
+	if s == nil {
+		return
+	}
+	s.Lock()
+	hooks := append([]TransitionHook{}, s.hooks...)
+	s.Unlock()
+	for _, hook := range hooks {
+		hook(t)
+	}
+}
+
+// recordTransition adds a transition to our event log.
+func recordTransition(t Transition) {
+	if DB.Events == nil {
+		return
+	}
+	DB.Events.Record(EventStateChange, t.Hostname, t.From.String()+" -> "+t.To.String())
+}
+
+// informTransition informs our general plugins of the member list change.
+func informTransition() {
+	if DB.Plugins == nil || DB.MemberList == nil {
+		return
+	}
+	InformMLSChange()
+}
@@ -0,0 +1,118 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"github.com/syleron/pulseha/rpc"
+	"testing"
+)
+
+func TestValidTransition(t *testing.T) {
+	cases := []struct {
+		from, to rpc.MemberStatus_Status
+		valid    bool
+	}{
+		{rpc.MemberStatus_UNCONFIGURED, rpc.MemberStatus_UNAVAILABLE, true},
+		{rpc.MemberStatus_UNAVAILABLE, rpc.MemberStatus_PASSIVE, true},
+		{rpc.MemberStatus_PASSIVE, rpc.MemberStatus_ACTIVE, true},
+		{rpc.MemberStatus_ACTIVE, rpc.MemberStatus_SUSPICIOUS, true},
+		{rpc.MemberStatus_SUSPICIOUS, rpc.MemberStatus_UNAVAILABLE, true},
+		{rpc.MemberStatus_ACTIVE, rpc.MemberStatus_ACTIVE, true},
+		{rpc.MemberStatus_MONITORING, rpc.MemberStatus_ACTIVE, false},
+		{rpc.MemberStatus_ACTIVE, rpc.MemberStatus_MONITORING, false},
+		{rpc.MemberStatus_UNAVAILABLE, rpc.MemberStatus_SUSPICIOUS, false},
+		{rpc.MemberStatus_LEAVING, rpc.MemberStatus_ACTIVE, false},
+		{rpc.MemberStatus_ACTIVE, rpc.MemberStatus_UNCONFIGURED, false},
+		{rpc.MemberStatus_ACTIVE, rpc.MemberStatus_MAINTENANCE, true},
+		{rpc.MemberStatus_MAINTENANCE, rpc.MemberStatus_PASSIVE, true},
+		{rpc.MemberStatus_MAINTENANCE, rpc.MemberStatus_ACTIVE, false},
+	}
+	for _, c := range cases {
+		if got := ValidTransition(c.from, c.to); got != c.valid {
+			t.Errorf("ValidTransition(%s, %s) = %v, want %v", c.from, c.to, got, c.valid)
+		}
+	}
+}
+
+func TestMemberSetStatus(t *testing.T) {
+	setupTestDB()
+	var fired []Transition
+	DB.StateMachine = &StateMachine{}
+	DB.StateMachine.AddHook(func(t Transition) {
+		fired = append(fired, t)
+	})
+	DB.Events = &EventLog{}
+	m := &Member{Hostname: "node1", Status: rpc.MemberStatus_UNCONFIGURED}
+	if err := m.SetStatus(rpc.MemberStatus_UNAVAILABLE); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetStatus(rpc.MemberStatus_PASSIVE); err != nil {
+		t.Fatal(err)
+	}
+	// Setting the same status again is a no-op
+	if err := m.SetStatus(rpc.MemberStatus_PASSIVE); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetStatus(rpc.MemberStatus_LEAVING); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetStatus(rpc.MemberStatus_ACTIVE); err == nil {
+		t.Fatal("expected transition from LEAVING to ACTIVE to be rejected")
+	}
+	if m.GetStatus() != rpc.MemberStatus_LEAVING {
+		t.Errorf("expected status to remain LEAVING, got %s", m.GetStatus())
+	}
+	if len(fired) != 3 {
+		t.Fatalf("expected 3 transition hooks to fire, got %d", len(fired))
+	}
+	if fired[1].From != rpc.MemberStatus_UNAVAILABLE || fired[1].To != rpc.MemberStatus_PASSIVE {
+		t.Errorf("unexpected transition %s -> %s", fired[1].From, fired[1].To)
+	}
+	if len(DB.Events.List()) != 3 {
+		t.Errorf("expected 3 recorded events, got %d", len(DB.Events.List()))
+	}
+}
+
+func TestUpdateAppliesPeerStatus(t *testing.T) {
+	setupTestPaths()
+	DB.StateMachine = &StateMachine{}
+	DB.Events = &EventLog{}
+	member := DB.MemberList.GetMemberByHostname("node2")
+	member.ApplyStatus(rpc.MemberStatus_UNAVAILABLE)
+	// Our peers may have seen node2 recover before it became suspicious
+	DB.MemberList.Update([]*rpc.MemberlistMember{{Hostname: "node2", Status: rpc.MemberStatus_SUSPICIOUS}})
+	if member.GetStatus() != rpc.MemberStatus_SUSPICIOUS {
+		t.Errorf("expected the status reported by our peers to be applied, got %s", member.GetStatus())
+	}
+}
+
+func TestMakeActiveRejectsIllegalTransition(t *testing.T) {
+	setupTestPaths()
+	DB.StateMachine = &StateMachine{}
+	DB.Events = &EventLog{}
+	DB.Plugins = &Plugins{}
+	DB.Election = &Election{}
+	local := DB.MemberList.GetMemberByHostname("node1")
+	local.ApplyStatus(rpc.MemberStatus_LEAVING)
+	if err := local.MakeActive(context.Background()); err == nil {
+		t.Fatal("expected a leaving member to be refused to become active")
+	}
+	if DB.Election.Leader != "" {
+		t.Errorf("expected no election term to be led, got %s", DB.Election.Leader)
+	}
+}
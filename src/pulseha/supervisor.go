@@ -0,0 +1,172 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// The names of our background tasks.
+// Note: Only one task per name can run at any time.
+const (
+	TaskMonitorClientConns  = "monitor-client-conns"
+	TaskHealthCheckHandler  = "health-check-handler"
+	TaskMonitorReceivedHCs  = "monitor-received-hcs"
+	TaskProcessHealthChecks = "process-health-checks"
+	TaskMeshHeartbeats      = "mesh-heartbeats"
+	TaskRenewCertificate    = "renew-certificate"
+	TaskReloadCertificates  = "reload-certificates"
+)
+
+// Task defines a supervised background loop.
+type Task struct {
+	Name     string
+	Interval time.Duration
+	Started  time.Time
+	Runs     uint64
+	cancel   context.CancelFunc
+	// Closed once the task has exited
+	done chan struct{}
+}
+
+// taskKey identifies the task a context was given to.
+type taskKey struct{}
+
+// Supervisor defines our task supervisor object.
+// It owns every background loop so they can be listed and stopped.
+type Supervisor struct {
+	tasks map[string]*Task
+	sync.Mutex
+}
+
+// Start runs method every delay until it returns true or the task is stopped.
+// Returns false if a task with the same name is already running.
+// Note: method is given the context of the task which it passes on when it stops itself.
+func (s *Supervisor) Start(name string, method func(ctx context.Context) bool, delay time.Duration) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.tasks == nil {
+		s.tasks = map[string]*Task{}
+	}
+	if _, ok := s.tasks[name]; ok {
+		DB.Logging.Debug("Supervisor:Start() Task " + name + " is already running")
+		return false
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	task := &Task{
+		Name:     name,
+		Interval: delay,
+		Started:  time.Now(),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	ctx = context.WithValue(ctx, taskKey{}, task)
+	s.tasks[name] = task
+	DB.Logging.Debug("Supervisor:Start() Starting task " + name)
+	go s.run(ctx, task, method)
+	return true
+}
+
+// run executes our task until it completes or is cancelled.
+func (s *Supervisor) run(ctx context.Context, task *Task, method func(ctx context.Context) bool) {
+	defer s.remove(task)
+	ticker := time.NewTicker(task.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Lock()
+			task.Runs++
+			s.Unlock()
+			if method(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// remove removes a task from our supervisor once it has exited.
+func (s *Supervisor) remove(task *Task) {
+	s.Lock()
+	defer s.Unlock()
+	task.cancel()
+	if current, ok := s.tasks[task.Name]; ok && current == task {
+		delete(s.tasks, task.Name)
+	}
+	close(task.done)
+}
+
+// Stop cancels a running task and waits until it has exited so it can be started again straight away.
+// Note: A task stopping itself passes the context it was given so we don't wait on it.
+func (s *Supervisor) Stop(ctx context.Context, name string) {
+	s.Lock()
+	task, ok := s.tasks[name]
+	s.Unlock()
+	if !ok {
+		return
+	}
+	DB.Logging.Debug("Supervisor:Stop() Stopping task " + name)
+	task.cancel()
+	if ctx.Value(taskKey{}) != task {
+		<-task.done
+	}
+}
+
+// StopAll cancels every running task and waits until they have exited.
+func (s *Supervisor) StopAll(ctx context.Context) {
+	s.Lock()
+	names := make([]string, 0, len(s.tasks))
+	for name := range s.tasks {
+		names = append(names, name)
+	}
+	s.Unlock()
+	for _, name := range names {
+		s.Stop(ctx, name)
+	}
+}
+
+// IsRunning returns whether a task is currently running.
+func (s *Supervisor) IsRunning(name string) bool {
+	s.Lock()
+	defer s.Unlock()
+	_, ok := s.tasks[name]
+	return ok
+}
+
+// List returns a copy of our running tasks sorted by name.
+func (s *Supervisor) List() []Task {
+	s.Lock()
+	defer s.Unlock()
+	tasks := []Task{}
+	for _, task := range s.tasks {
+		tasks = append(tasks, Task{
+			Name:     task.Name,
+			Interval: task.Interval,
+			Started:  task.Started,
+			Runs:     task.Runs,
+		})
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].Name < tasks[j].Name
+	})
+	return tasks
+}
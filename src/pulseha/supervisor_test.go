@@ -0,0 +1,126 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/packages/logging"
+	"github.com/syleron/pulseha/rpc"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupTestDB sets up a database with a logger that doesn't broadcast.
+func setupTestDB() {
+	DB = &Database{
+		Logging: logging.Logging{
+			Logger: log.New(),
+			Level:  rpc.LogsRequest_INFO,
+			Broadcast: func(funcName client.ProtoFunction, data interface{}) []error {
+				return nil
+			},
+		},
+	}
+}
+
+func TestSupervisorSingleInstance(t *testing.T) {
+	setupTestDB()
+	s := &Supervisor{}
+	defer s.StopAll(context.Background())
+	if !s.Start("task", func(ctx context.Context) bool { return false }, time.Millisecond) {
+		t.Fatal("expected task to start")
+	}
+	if s.Start("task", func(ctx context.Context) bool { return false }, time.Millisecond) {
+		t.Fatal("expected a second task with the same name to be refused")
+	}
+	if len(s.List()) != 1 {
+		t.Fatalf("expected 1 running task, got %d", len(s.List()))
+	}
+}
+
+func TestSupervisorStop(t *testing.T) {
+	setupTestDB()
+	s := &Supervisor{}
+	var runs int32
+	s.Start("task", func(ctx context.Context) bool {
+		atomic.AddInt32(&runs, 1)
+		return false
+	}, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	s.StopAll(context.Background())
+	if s.IsRunning("task") {
+		t.Fatal("expected task to be stopped")
+	}
+	stopped := atomic.LoadInt32(&runs)
+	time.Sleep(20 * time.Millisecond)
+	if atomic.LoadInt32(&runs) != stopped {
+		t.Error("expected task to no longer run once stopped")
+	}
+	if !s.Start("task", func(ctx context.Context) bool { return true }, time.Millisecond) {
+		t.Error("expected task to start again once stopped")
+	}
+}
+
+func TestSupervisorTaskCompletes(t *testing.T) {
+	setupTestDB()
+	s := &Supervisor{}
+	s.Start("task", func(ctx context.Context) bool { return true }, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	if s.IsRunning("task") {
+		t.Error("expected task to be removed once complete")
+	}
+}
+
+func TestSupervisorStopWaitsForIteration(t *testing.T) {
+	setupTestDB()
+	s := &Supervisor{}
+	started := make(chan struct{})
+	var finished int32
+	s.Start("task", func(ctx context.Context) bool {
+		close(started)
+		time.Sleep(20 * time.Millisecond)
+		atomic.StoreInt32(&finished, 1)
+		return true
+	}, time.Millisecond)
+	<-started
+	s.Stop(context.Background(), "task")
+	if atomic.LoadInt32(&finished) != 1 {
+		t.Fatal("expected stop to wait for the running iteration")
+	}
+	if !s.Start("task", func(ctx context.Context) bool { return true }, time.Millisecond) {
+		t.Error("expected task to start again straight after being stopped")
+	}
+}
+
+func TestSupervisorTaskStopsItself(t *testing.T) {
+	setupTestDB()
+	s := &Supervisor{}
+	stopped := make(chan struct{})
+	s.Start("task", func(ctx context.Context) bool {
+		s.Stop(ctx, "task")
+		close(stopped)
+		return true
+	}, time.Millisecond)
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected a task to be able to stop itself")
+	}
+}
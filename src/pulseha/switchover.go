@@ -0,0 +1,325 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"errors"
+	"github.com/syleron/pulseha/packages/client"
+	"github.com/syleron/pulseha/packages/network"
+	"github.com/syleron/pulseha/rpc"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Switchover step names
+const (
+	StepPreflight      = "preflight"
+	StepRelease        = "release"
+	StepConfirmRelease = "confirm release"
+	StepActivate       = "activate"
+	StepVerify         = "verify"
+	StepRollback       = "rollback"
+)
+
+// Network functions used during a switchover.
+// Note: These are variables so they can be replaced when testing.
+var (
+	interfaceExists = func(iface string) bool {
+		exists, _ := network.InterfaceExist(iface)
+		return exists
+	}
+	pingAddress     = network.ICMPv4
+	announceAddress = network.SendGARP
+)
+
+// Switchover moves the active role from the current active member to a target member.
+// Every step is recorded and a failure in any step rolls back the steps taken so far.
+type Switchover struct {
+	// The member becoming active
+	Target *Member
+	// The member that was active before the switchover (if any)
+	Previous *Member
+	// Skip the health score comparison with the previous active
+	Force bool
+	// Ping the addresses of the target's groups once it is active
+	// Note: Pings may fail when running unprivileged or behind a firewall so this is opt-in.
+	Ping bool
+	// The steps taken so far
+	Steps []*rpc.SwitchoverStep
+}
+
+// step records the result of a switchover step.
+func (s *Switchover) step(name string, err error, message string) error {
+	step := &rpc.SwitchoverStep{
+		Name:    name,
+		Success: err == nil,
+		Message: message,
+	}
+	if err != nil {
+		step.Message = err.Error()
+		DB.Logging.Warn("Switchover " + name + " failed: " + err.Error())
+	} else {
+		DB.Logging.Debug("Switchover:step() " + name + ": " + message)
+	}
+	s.Steps = append(s.Steps, step)
+	return err
+}
+
+// Run performs the switchover.
+func (s *Switchover) Run(ctx context.Context) error {
+	if err := s.step(StepPreflight, s.preflight(), s.Target.GetHostname()+" is ready to become active"); err != nil {
+		return err
+	}
+	if s.Previous != nil {
+		err := s.Previous.MakePassive(ctx)
+		if err == nil {
+			// Update our local value for the previous active
+			err = s.Previous.SetStatus(standbyStatus(s.Previous.GetHostname()))
+		}
+		if err := s.step(StepRelease, err, s.Previous.GetHostname()+" is now passive"); err != nil {
+			s.rollback(ctx, false)
+			return err
+		}
+		if err := s.step(StepConfirmRelease, s.confirmRelease(), s.Previous.GetHostname()+" has released its floating IP groups"); err != nil {
+			s.rollback(ctx, false)
+			return err
+		}
+	}
+	if err := s.step(StepActivate, s.Target.MakeActive(ctx), s.Target.GetHostname()+" is now active"); err != nil {
+		s.rollback(ctx, true)
+		return err
+	}
+	message, err := s.verify()
+	if err := s.step(StepVerify, err, message); err != nil {
+		s.rollback(ctx, true)
+		return err
+	}
+	return nil
+}
+
+// preflight makes sure the target is ready to become active.
+func (s *Switchover) preflight() error {
+	hostname := s.Target.GetHostname()
+	switch s.Target.GetStatus() {
+	case rpc.MemberStatus_ACTIVE:
+		return errors.New(hostname + " is already active")
+	case rpc.MemberStatus_UNAVAILABLE, rpc.MemberStatus_UNCONFIGURED:
+		// If we are the only node and just configured we will be unavailable
+		if DB.Config.NodeCount() > 1 {
+			return errors.New(hostname + " is unavailable")
+		}
+	}
+	// Monitoring nodes and nodes in maintenance can never become active
+	if !nodeIsElectable(hostname) {
+		return errors.New(hostname + " is a monitoring node or in maintenance")
+	}
+	var score int
+	if isLocalMember(hostname) {
+		if err := preflightLocal(); err != nil {
+			return err
+		}
+		score = s.Target.GetScore()
+	} else {
+		if err := s.Target.Connect(); err != nil {
+			return errors.New("unable to connect to " + hostname + ": " + err.Error())
+		}
+		r, err := s.Target.Send(client.SendPreflight, &rpc.PreflightRequest{
+			Member: hostname,
+		})
+		if err != nil {
+			return errors.New("unable to reach " + hostname + ": " + err.Error())
+		}
+		resp := r.(*rpc.PreflightResponse)
+		if !resp.Success {
+			return errors.New(resp.Message)
+		}
+		score = int(resp.Score)
+	}
+	if !s.Force && s.Previous != nil && score < s.Previous.GetScore() {
+		return errors.New(hostname + " has a lower health score than " + s.Previous.GetHostname() +
+			" (" + strconv.Itoa(score) + " < " + strconv.Itoa(s.Previous.GetScore()) + ")")
+	}
+	return nil
+}
+
+// confirmRelease makes sure the previous active no longer holds groups that follow the active.
+func (s *Switchover) confirmRelease() error {
+	hostname := s.Previous.GetHostname()
+	groups, err := memberHeldGroups(s.Previous, false)
+	if err != nil {
+		return err
+	}
+	var held []string
+	for _, group := range groups {
+		// Groups placed on the member stay with it
+		if DB.Config.GroupOwners[group] != hostname {
+			held = append(held, group)
+		}
+	}
+	if len(held) > 0 {
+		return errors.New(hostname + " still holds " + strings.Join(held, ", "))
+	}
+	return nil
+}
+
+// verify makes sure the target holds its floating IP groups and, when asked to, that their addresses respond.
+func (s *Switchover) verify() (string, error) {
+	hostname := s.Target.GetHostname()
+	groups, err := memberHeldGroups(s.Target, true)
+	if err != nil {
+		return "", err
+	}
+	held := map[string]bool{}
+	for _, group := range groups {
+		held[group] = true
+	}
+	var missing []string
+	for _, group := range expectedGroups(hostname) {
+		if !held[group] {
+			missing = append(missing, group)
+		}
+	}
+	if len(missing) > 0 {
+		return "", errors.New(hostname + " did not bring up " + strings.Join(missing, ", "))
+	}
+	// Without a networking plugin no addresses are brought up
+	if DB.Plugins == nil || DB.Plugins.GetNetworkingPlugin() == nil {
+		return hostname + " holds " + strconv.Itoa(len(groups)) + " group(s) (no networking plugin, addresses not checked)", nil
+	}
+	if !s.Ping {
+		return hostname + " holds " + strconv.Itoa(len(groups)) + " group(s)", nil
+	}
+	addresses := 0
+	for _, group := range groups {
+		for _, ip := range DB.Config.Groups[group] {
+			addr, _, err := net.ParseCIDR(ip)
+			// Note: Only IPv4 addresses can be checked
+			if err != nil || addr.To4() == nil {
+				continue
+			}
+			if err := pingAddress(addr.String()); err != nil {
+				return "", errors.New(addr.String() + " in group " + group + " does not respond")
+			}
+			addresses++
+		}
+	}
+	return hostname + " holds " + strconv.Itoa(len(groups)) + " group(s) and " +
+		strconv.Itoa(addresses) + " address(es) respond", nil
+}
+
+// rollback undoes the steps taken so far and reinstates the previous active.
+func (s *Switchover) rollback(ctx context.Context, activated bool) {
+	if activated {
+		err := s.Target.MakePassive(ctx)
+		if err == nil {
+			err = s.Target.SetStatus(standbyStatus(s.Target.GetHostname()))
+		}
+		s.step(StepRollback, err, s.Target.GetHostname()+" is passive again")
+	}
+	if s.Previous == nil {
+		return
+	}
+	s.step(StepRollback, s.Previous.MakeActive(ctx), s.Previous.GetHostname()+" is active again")
+}
+
+// preflightLocal makes sure the local node is ready to become active.
+func preflightLocal() error {
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return errors.New("unable to retrieve local node configuration")
+	}
+	var missing []string
+	for iface, groups := range localNode.IPGroups {
+		if len(groups) > 0 && !interfaceExists(iface) {
+			missing = append(missing, iface)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return errors.New(localNode.Hostname + " is missing network interface(s) " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// expectedGroups returns the groups a member should hold once it becomes active.
+func expectedGroups(hostname string) []string {
+	_, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return nil
+	}
+	groups := []string{}
+	for _, assigned := range node.IPGroups {
+		for _, group := range assigned {
+			// Groups placed on another available member stay there
+			if owner := DB.Config.GroupOwners[group]; owner != "" && owner != hostname && groupOwner(group) == owner {
+				continue
+			}
+			groups = append(groups, group)
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// localHeldGroups returns the groups up on the local node.
+// Announced groups have their IPv4 addresses announced using gratuitous ARP.
+func localHeldGroups(announce bool) []string {
+	groups := []string{}
+	for group, iface := range DB.Placement.Groups() {
+		groups = append(groups, group)
+		if !announce || !interfaceExists(iface) {
+			continue
+		}
+		for _, ip := range DB.Config.Groups[group] {
+			if addr, _, err := net.ParseCIDR(ip); err == nil && addr.To4() != nil {
+				announceAddress(iface, ip)
+			}
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// memberHeldGroups returns the groups up on a member.
+func memberHeldGroups(member *Member, announce bool) ([]string, error) {
+	if isLocalMember(member.GetHostname()) {
+		return localHeldGroups(announce), nil
+	}
+	if err := member.Connect(); err != nil {
+		return nil, err
+	}
+	r, err := member.Send(client.SendGroupStatus, &rpc.GroupStatusRequest{
+		Announce: announce,
+	})
+	if err != nil {
+		return nil, errors.New("unable to retrieve the groups held by " + member.GetHostname() + ": " + err.Error())
+	}
+	resp := r.(*rpc.GroupStatusResponse)
+	if !resp.Success {
+		return nil, errors.New(resp.Message)
+	}
+	return resp.Groups, nil
+}
+
+// isLocalMember determines whether a hostname belongs to the local node.
+func isLocalMember(hostname string) bool {
+	localNode, err := DB.Config.GetLocalNode()
+	return err == nil && localNode.Hostname == hostname
+}
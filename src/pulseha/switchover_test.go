@@ -0,0 +1,149 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"context"
+	"errors"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/rpc"
+	"reflect"
+	"testing"
+)
+
+// setupTestSwitchover sets up a two node cluster where node1 is the local node.
+func setupTestSwitchover(statuses map[string]rpc.MemberStatus_Status) {
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1", IPGroups: map[string][]string{"eth0": {"group1", "group2"}}},
+		"b": {Hostname: "node2", IPGroups: map[string][]string{"eth0": {"group1", "group2"}}},
+	}, statuses)
+	DB.Config.Pulse.LocalNode = "a"
+	DB.Config.Groups = map[string][]string{
+		"group1": {"10.0.0.1/24"},
+		"group2": {"10.0.0.2/24"},
+	}
+	DB.Placement = &Placement{}
+}
+
+func TestPreflightLocalMissingInterface(t *testing.T) {
+	setupTestSwitchover(nil)
+	defer func(f func(string) bool) { interfaceExists = f }(interfaceExists)
+	interfaceExists = func(iface string) bool { return false }
+	if err := preflightLocal(); err == nil {
+		t.Error("expected preflight to fail when an interface is missing")
+	}
+	interfaceExists = func(iface string) bool { return true }
+	if err := preflightLocal(); err != nil {
+		t.Errorf("expected preflight to pass, got %v", err)
+	}
+}
+
+func TestSwitchoverPreflightFailureWithoutActive(t *testing.T) {
+	setupTestSwitchover(map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_UNAVAILABLE,
+	})
+	defer func(f func(string) bool) { interfaceExists = f }(interfaceExists)
+	interfaceExists = func(iface string) bool { return false }
+	steps, err := DB.MemberList.Switchover(context.Background(), "node1", false, false)
+	if err == nil {
+		t.Fatal("expected the switchover to fail")
+	}
+	if len(steps) != 1 || steps[0].Name != StepPreflight || steps[0].Success {
+		t.Errorf("expected a single failed preflight step, got %v", steps)
+	}
+	if DB.MemberList.GetMemberByHostname("node1").GetStatus() != rpc.MemberStatus_PASSIVE {
+		t.Error("expected node1 to remain passive")
+	}
+}
+
+func TestSwitchoverRejectsLowerScore(t *testing.T) {
+	setupTestSwitchover(map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_ACTIVE,
+	})
+	defer func(f func(string) bool) { interfaceExists = f }(interfaceExists)
+	interfaceExists = func(iface string) bool { return true }
+	DB.MemberList.GetMemberByHostname("node2").Score = 10
+	s := &Switchover{
+		Target:   DB.MemberList.GetMemberByHostname("node1"),
+		Previous: DB.MemberList.GetMemberByHostname("node2"),
+	}
+	if err := s.preflight(); err == nil {
+		t.Error("expected preflight to fail for a lower health score")
+	}
+	s.Force = true
+	if err := s.preflight(); err != nil {
+		t.Errorf("expected a forced preflight to pass, got %v", err)
+	}
+}
+
+func TestSwitchoverConfirmRelease(t *testing.T) {
+	setupTestSwitchover(map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+	})
+	s := &Switchover{
+		Target:   DB.MemberList.GetMemberByHostname("node2"),
+		Previous: DB.MemberList.GetMemberByHostname("node1"),
+	}
+	DB.Placement.setUp("group1", "eth0")
+	if err := s.confirmRelease(); err == nil {
+		t.Error("expected confirm release to fail while group1 is still up")
+	}
+	// Groups placed on the previous active stay with it
+	DB.Config.GroupOwners = map[string]string{"group1": "node1"}
+	if err := s.confirmRelease(); err != nil {
+		t.Errorf("expected confirm release to pass, got %v", err)
+	}
+}
+
+func TestExpectedGroups(t *testing.T) {
+	setupTestSwitchover(map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_PASSIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+	})
+	DB.Config.GroupOwners = map[string]string{"group2": "node1"}
+	if got := expectedGroups("node2"); !reflect.DeepEqual(got, []string{"group1"}) {
+		t.Errorf("expected node2 to hold group1, got %v", got)
+	}
+	if got := expectedGroups("node1"); !reflect.DeepEqual(got, []string{"group1", "group2"}) {
+		t.Errorf("expected node1 to hold group1 and group2, got %v", got)
+	}
+}
+
+func TestSwitchoverVerifyPingIsOptIn(t *testing.T) {
+	setupTestSwitchover(map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+	})
+	DB.Plugins = &Plugins{modules: []*Plugin{{Name: "netcore", Type: PluginNetworking}}}
+	DB.Placement.setUp("group1", "eth0")
+	DB.Placement.setUp("group2", "eth0")
+	defer func(f func(string) bool) { interfaceExists = f }(interfaceExists)
+	interfaceExists = func(iface string) bool { return false }
+	defer func(f func(string) error) { pingAddress = f }(pingAddress)
+	pingAddress = func(addr string) error { return errors.New("no response") }
+	s := &Switchover{Target: DB.MemberList.GetMemberByHostname("node1")}
+	if _, err := s.verify(); err != nil {
+		t.Errorf("expected addresses not to be pinged unless asked to, got %v", err)
+	}
+	s.Ping = true
+	if _, err := s.verify(); err == nil {
+		t.Error("expected verify to fail when an address doesn't respond")
+	}
+}
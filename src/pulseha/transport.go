@@ -0,0 +1,325 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"bytes"
+	"errors"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/packages/heartbeat"
+	"github.com/syleron/pulseha/rpc"
+	"google.golang.org/protobuf/proto"
+	"net"
+	"sync"
+	"time"
+)
+
+// HeartbeatTransport sends and receives health checks as signed UDP datagrams.
+// Note: gRPC is still used for everything other than health checks.
+type HeartbeatTransport struct {
+	conn *heartbeat.Conn
+	// Closed once we have stopped receiving
+	done chan struct{}
+	// The health checks we are still handling
+	handlers sync.WaitGroup
+	// The members we are waiting on a health check response from
+	pending map[pendingResponse]chan *rpc.HealthCheckResponse
+	// The last health check sent to the multicast group
+	round multicastRound
+	sync.Mutex
+}
+
+// pendingResponse identifies the response to a health check sent to a member.
+type pendingResponse struct {
+	seq      uint64
+	hostname string
+}
+
+// multicastRound defines a health check sent to the multicast group.
+// Every member waiting on the same health check shares a single datagram.
+type multicastRound struct {
+	seq     uint64
+	payload []byte
+	sent    time.Time
+	// Responses received before the member started waiting
+	responses map[string]*rpc.HealthCheckResponse
+}
+
+// heartbeatKey returns the key used to sign health checks.
+// Note: The key is part of the replicated cluster config so every member has it.
+func heartbeatKey() []byte {
+	return DB.Config.GetHeartbeatKey()
+}
+
+// ensureHeartbeatKey generates the heartbeat key for clusters that don't have one yet.
+// Note: Clusters created by older versions of PulseHA signed health checks with the cluster token.
+func ensureHeartbeatKey() {
+	if len(DB.Config.GetHeartbeatKey()) == 0 {
+		DB.Config.SetHeartbeatKey(generateRandomString(32))
+	}
+}
+
+// Start listens for health checks when the UDP transport is configured.
+// Received health checks are passed to the handler and its response is sent back.
+func (t *HeartbeatTransport) Start(handler func(*rpc.HealthCheckRequest) *rpc.HealthCheckResponse) error {
+	if DB.Config.GetHeartbeatTransport() != config.TransportUDP {
+		return nil
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil {
+		return err
+	}
+	if len(heartbeatKey()) == 0 {
+		return errors.New("the cluster has no heartbeat key. Please generate one with pulsectl token")
+	}
+	// Packets older than our failover limit are of no use to us
+	window := time.Duration(DB.Config.Pulse.FailOverLimit) * time.Millisecond
+	var conn *heartbeat.Conn
+	if group := DB.Config.GetHeartbeatMulticastGroup(); group != "" {
+		conn, err = heartbeat.ListenMulticast(group, heartbeatKey, window)
+	} else {
+		// Health checks may arrive over any of our heartbeat paths
+		bindIP := localNode.IP
+		if len(localNode.HeartbeatAddresses) > 0 {
+			bindIP = ""
+		}
+		conn, err = heartbeat.Listen(net.JoinHostPort(bindIP, DB.Config.GetHeartbeatPort(localNode)), heartbeatKey, window)
+	}
+	if err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	t.Lock()
+	t.conn = conn
+	t.done = done
+	t.pending = map[pendingResponse]chan *rpc.HealthCheckResponse{}
+	t.round = multicastRound{}
+	t.Unlock()
+	DB.Logging.Info("PulseHA heartbeat transport listening on " + conn.LocalAddr().String())
+	go t.serve(conn, done, handler)
+	return nil
+}
+
+// Stop closes the UDP transport and waits until we have stopped receiving and handling health checks.
+func (t *HeartbeatTransport) Stop() {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	if t.conn == nil {
+		t.Unlock()
+		return
+	}
+	t.conn.Close()
+	t.conn = nil
+	done := t.done
+	t.Unlock()
+	<-done
+	t.handlers.Wait()
+}
+
+// Running determines whether health checks are sent over UDP.
+func (t *HeartbeatTransport) Running() bool {
+	if t == nil {
+		return false
+	}
+	t.Lock()
+	defer t.Unlock()
+	return t.conn != nil
+}
+
+// serve handles the datagrams we receive until the connection is closed.
+func (t *HeartbeatTransport) serve(conn *heartbeat.Conn, done chan struct{}, handler func(*rpc.HealthCheckRequest) *rpc.HealthCheckResponse) {
+	defer close(done)
+	for {
+		p, from, err := conn.Receive()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				DB.Logging.Debug("HeartbeatTransport:serve() Connection closed")
+				return
+			}
+			if from != nil {
+				DB.Logging.Debug("HeartbeatTransport:serve() Dropped datagram from " + from.String() + ": " + err.Error())
+			}
+			continue
+		}
+		switch p.Type {
+		case heartbeat.TypeRequest:
+			// A slow handler must not hold up the responses we are waiting on
+			t.handlers.Add(1)
+			go func() {
+				defer t.handlers.Done()
+				t.handleRequest(conn, p, from, handler)
+			}()
+		case heartbeat.TypeResponse:
+			t.handleResponse(p, from)
+		}
+	}
+}
+
+// handleRequest passes a health check to the handler and replies with its response.
+func (t *HeartbeatTransport) handleRequest(conn *heartbeat.Conn, p *heartbeat.Packet, from *net.UDPAddr, handler func(*rpc.HealthCheckRequest) *rpc.HealthCheckResponse) {
+	in := &rpc.HealthCheckRequest{}
+	if err := proto.Unmarshal(p.Payload, in); err != nil {
+		DB.Logging.Debug("HeartbeatTransport:handleRequest() Unable to decode health check: " + err.Error())
+		return
+	}
+	localNode, err := DB.Config.GetLocalNode()
+	if err != nil || in.Hostname == localNode.Hostname {
+		return
+	}
+	if !nodeHasAddress(in.Hostname, from.IP) {
+		DB.Logging.Warn("Health check from " + from.String() + " claiming to be " + in.Hostname + " was ignored")
+		return
+	}
+	resp := handler(in)
+	resp.Hostname = localNode.Hostname
+	payload, err := proto.Marshal(resp)
+	if err != nil {
+		return
+	}
+	if _, err := conn.Send([]*net.UDPAddr{from}, heartbeat.TypeResponse, p.Seq, payload); err != nil {
+		DB.Logging.Debug("HeartbeatTransport:handleRequest() Unable to respond to " + in.Hostname + ": " + err.Error())
+	}
+}
+
+// handleResponse passes a health check response to the member waiting on it.
+func (t *HeartbeatTransport) handleResponse(p *heartbeat.Packet, from *net.UDPAddr) {
+	resp := &rpc.HealthCheckResponse{}
+	if err := proto.Unmarshal(p.Payload, resp); err != nil {
+		DB.Logging.Debug("HeartbeatTransport:handleResponse() Unable to decode health check response: " + err.Error())
+		return
+	}
+	if !nodeHasAddress(resp.Hostname, from.IP) {
+		DB.Logging.Warn("Health check response from " + from.String() + " claiming to be " + resp.Hostname + " was ignored")
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	key := pendingResponse{seq: p.Ack, hostname: resp.Hostname}
+	if ch, ok := t.pending[key]; ok {
+		ch <- resp
+		delete(t.pending, key)
+		return
+	}
+	if t.round.responses != nil && t.round.seq == p.Ack {
+		t.round.responses[resp.Hostname] = resp
+	}
+}
+
+// Exchange sends a health check to a member and waits for its response.
+// Note: The response must arrive within the health check interval.
+func (t *HeartbeatTransport) Exchange(hostname string, data *rpc.HealthCheckRequest) (*rpc.HealthCheckResponse, error) {
+	payload, err := proto.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	timeout := healthCheckTimeout()
+	// Register the member as waiting before sending so the response can't be missed
+	t.Lock()
+	if t.conn == nil {
+		t.Unlock()
+		return nil, errors.New("heartbeat transport is not running")
+	}
+	var seq uint64
+	if group := DB.Config.GetHeartbeatMulticastGroup(); group != "" {
+		// Every member receives the same multicast health check
+		if bytes.Equal(t.round.payload, payload) && time.Since(t.round.sent) < timeout/2 {
+			seq = t.round.seq
+			if resp, ok := t.round.responses[hostname]; ok {
+				delete(t.round.responses, hostname)
+				t.Unlock()
+				return resp, nil
+			}
+		} else {
+			addr, err := net.ResolveUDPAddr("udp", group)
+			if err == nil {
+				seq, err = t.conn.Send([]*net.UDPAddr{addr}, heartbeat.TypeRequest, 0, payload)
+			}
+			if err != nil {
+				t.Unlock()
+				return nil, err
+			}
+			t.round = multicastRound{
+				seq:       seq,
+				payload:   payload,
+				sent:      time.Now(),
+				responses: map[string]*rpc.HealthCheckResponse{},
+			}
+		}
+	} else {
+		addrs, err := heartbeatAddresses(hostname)
+		if err == nil {
+			seq, err = t.conn.Send(addrs, heartbeat.TypeRequest, 0, payload)
+		}
+		if err != nil {
+			t.Unlock()
+			return nil, err
+		}
+	}
+	key := pendingResponse{seq: seq, hostname: hostname}
+	ch := make(chan *rpc.HealthCheckResponse, 1)
+	t.pending[key] = ch
+	t.Unlock()
+	select {
+	case resp := <-ch:
+		return resp, nil
+	case <-time.After(timeout):
+		t.Lock()
+		delete(t.pending, key)
+		t.Unlock()
+		return nil, errors.New("no health check response received from " + hostname)
+	}
+}
+
+// heartbeatAddresses returns the UDP address of each heartbeat path to a member.
+// Note: The same datagram is sent over every path and is only handled once.
+func heartbeatAddresses(hostname string) ([]*net.UDPAddr, error) {
+	_, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return nil, err
+	}
+	port := DB.Config.GetHeartbeatPort(node)
+	addrs := []*net.UDPAddr{}
+	for _, path := range node.HeartbeatPaths() {
+		host, _, err := net.SplitHostPort(path)
+		if err != nil {
+			continue
+		}
+		addr, err := net.ResolveUDPAddr("udp", net.JoinHostPort(host, port))
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// nodeHasAddress determines whether an IP address belongs to one of a node's heartbeat paths.
+func nodeHasAddress(hostname string, ip net.IP) bool {
+	_, node, err := nodeGetByHostname(hostname)
+	if err != nil {
+		return false
+	}
+	for _, path := range node.HeartbeatPaths() {
+		host, _, err := net.SplitHostPort(path)
+		if err == nil && net.ParseIP(host).Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+// PulseHA - HA Cluster Daemon
+// Copyright (C) 2017-2021  Andrew Zak <andrew@linux.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pulseha
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/syleron/pulseha/packages/config"
+	"github.com/syleron/pulseha/packages/heartbeat"
+	"github.com/syleron/pulseha/packages/security"
+	"github.com/syleron/pulseha/packages/utils"
+	"github.com/syleron/pulseha/rpc"
+	"google.golang.org/protobuf/proto"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// freeUDPPort returns a loopback UDP port that is not in use.
+func freeUDPPort(t *testing.T) string {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return strconv.Itoa(conn.LocalAddr().(*net.UDPAddr).Port)
+}
+
+// setupTestTransport starts the UDP transport for node1 on loopback and returns a connection acting as node2.
+func setupTestTransport(t *testing.T, handler func(*rpc.HealthCheckRequest) *rpc.HealthCheckResponse) (*HeartbeatTransport, *heartbeat.Conn) {
+	remotePort := freeUDPPort(t)
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: "node1", IP: "127.0.0.1", Port: freeUDPPort(t)},
+		"b": {Hostname: "node2", IP: "127.0.0.1", Port: remotePort},
+	}, map[string]rpc.MemberStatus_Status{
+		"node1": rpc.MemberStatus_ACTIVE,
+		"node2": rpc.MemberStatus_PASSIVE,
+	})
+	DB.Config.Pulse.LocalNode = "a"
+	DB.Config.HeartbeatKey = "key"
+	DB.Config.Pulse.HealthCheckInterval = 1000
+	DB.Config.Pulse.FailOverLimit = 10000
+	DB.Config.Pulse.HeartbeatTransport = config.TransportUDP
+	transport := &HeartbeatTransport{}
+	if err := transport.Start(handler); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(transport.Stop)
+	remote, err := heartbeat.Listen("127.0.0.1:"+remotePort, heartbeatKey, 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { remote.Close() })
+	return transport, remote
+}
+
+// localTransportAddr returns the address node1 receives health checks on.
+func localTransportAddr(t *testing.T) *net.UDPAddr {
+	localNode, _ := DB.Config.GetLocalNode()
+	addr, err := net.ResolveUDPAddr("udp", "127.0.0.1:"+localNode.Port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+func TestHeartbeatTransportDisabled(t *testing.T) {
+	setupTestPaths()
+	transport := &HeartbeatTransport{}
+	if err := transport.Start(nil); err != nil {
+		t.Fatal(err)
+	}
+	if transport.Running() {
+		t.Error("expected the transport not to run when health checks are sent over gRPC")
+	}
+}
+
+func TestHeartbeatTransportExchange(t *testing.T) {
+	transport, remote := setupTestTransport(t, nil)
+	// Act as node2 and answer a single health check
+	go func() {
+		p, from, err := remote.Receive()
+		if err != nil {
+			return
+		}
+		in := &rpc.HealthCheckRequest{}
+		if proto.Unmarshal(p.Payload, in) != nil || in.Hostname != "node1" {
+			return
+		}
+		payload, _ := proto.Marshal(&rpc.HealthCheckResponse{Score: 5, Term: in.Term, Hostname: "node2"})
+		remote.Send([]*net.UDPAddr{from}, heartbeat.TypeResponse, p.Seq, payload)
+	}()
+	resp, err := transport.Exchange("node2", &rpc.HealthCheckRequest{Hostname: "node1", Term: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Score != 5 || resp.Term != 2 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestHeartbeatTransportExchangeTimeout(t *testing.T) {
+	transport, _ := setupTestTransport(t, nil)
+	DB.Config.Pulse.HealthCheckInterval = 50
+	if _, err := transport.Exchange("node2", &rpc.HealthCheckRequest{Hostname: "node1"}); err == nil {
+		t.Error("expected an error when no response is received")
+	}
+}
+
+func TestHeartbeatTransportHandlesRequest(t *testing.T) {
+	received := make(chan *rpc.HealthCheckRequest, 1)
+	_, remote := setupTestTransport(t, func(in *rpc.HealthCheckRequest) *rpc.HealthCheckResponse {
+		received <- in
+		return &rpc.HealthCheckResponse{Score: 3, Term: in.Term}
+	})
+	payload, _ := proto.Marshal(&rpc.HealthCheckRequest{Hostname: "node2", Term: 4})
+	seq, err := remote.Send([]*net.UDPAddr{localTransportAddr(t)}, heartbeat.TypeRequest, 0, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in := <-received; in.Hostname != "node2" || in.Term != 4 {
+		t.Errorf("unexpected health check %+v", in)
+	}
+	p, _, err := receiveWithin(remote, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := &rpc.HealthCheckResponse{}
+	if err := proto.Unmarshal(p.Payload, resp); err != nil {
+		t.Fatal(err)
+	}
+	if p.Ack != seq || resp.Hostname != "node1" || resp.Score != 3 {
+		t.Errorf("unexpected response %+v (ack %d)", resp, p.Ack)
+	}
+}
+
+func TestHeartbeatTransportIgnoresUnknownSender(t *testing.T) {
+	_, remote := setupTestTransport(t, func(in *rpc.HealthCheckRequest) *rpc.HealthCheckResponse {
+		t.Error("expected the health check to be ignored")
+		return &rpc.HealthCheckResponse{}
+	})
+	payload, _ := proto.Marshal(&rpc.HealthCheckRequest{Hostname: "node3"})
+	if _, err := remote.Send([]*net.UDPAddr{localTransportAddr(t)}, heartbeat.TypeRequest, 0, payload); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := receiveWithin(remote, 100*time.Millisecond); err == nil {
+		t.Error("expected no response to a health check from an unknown node")
+	}
+}
+
+func TestNodeHasAddress(t *testing.T) {
+	setupTestPaths()
+	if !nodeHasAddress("node2", net.ParseIP("10.0.0.2")) || !nodeHasAddress("node2", net.ParseIP("192.168.100.2")) {
+		t.Error("expected the bind and heartbeat addresses of node2 to match")
+	}
+	if nodeHasAddress("node2", net.ParseIP("10.0.0.1")) || nodeHasAddress("node3", net.ParseIP("10.0.0.2")) {
+		t.Error("expected addresses of other nodes not to match")
+	}
+}
+
+var errTestTimeout = errors.New("timed out waiting for a datagram")
+
+// receiveWithin waits for a heartbeat datagram for a limited time.
+func receiveWithin(conn *heartbeat.Conn, timeout time.Duration) (*heartbeat.Packet, *net.UDPAddr, error) {
+	type result struct {
+		p    *heartbeat.Packet
+		from *net.UDPAddr
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		p, from, err := conn.Receive()
+		ch <- result{p, from, err}
+	}()
+	select {
+	case r := <-ch:
+		return r.p, r.from, r.err
+	case <-time.After(timeout):
+		return nil, nil, errTestTimeout
+	}
+}
+
+func TestJoinSharesHeartbeatKey(t *testing.T) {
+	hostname, err := utils.GetHostname()
+	if err != nil {
+		t.Fatal(err)
+	}
+	location := config.CONFIG_LOCATION
+	config.CONFIG_LOCATION = filepath.Join(t.TempDir(), "config.json")
+	defer func() {
+		config.CONFIG_LOCATION = location
+	}()
+	setupTestMemberList(map[string]*config.Node{
+		"a": {Hostname: hostname, IP: "127.0.0.1", Port: "8443"},
+	}, map[string]rpc.MemberStatus_Status{
+		hostname: rpc.MemberStatus_ACTIVE,
+	})
+	DB.Config.Groups = map[string][]string{}
+	DB.Config.Pulse.LocalNode = "a"
+	DB.Config.Pulse.HealthCheckInterval = 1000
+	DB.Config.Pulse.FailOverInterval = 5000
+	DB.Config.Pulse.FailOverLimit = 10000
+	DB.Config.Pulse.ClusterToken = security.GenerateSHA256Hash("token")
+	setupTestCerts(t)
+	csr, _, err := security.GenerateCSR("node2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, _ := json.Marshal(&config.Node{Hostname: "node2", IP: "127.0.0.2", Port: "8443"})
+	resp, err := (&Server{}).Join(context.Background(), &rpc.JoinRequest{
+		Uid:    "b",
+		Token:  "token",
+		Config: node,
+		Csr:    csr,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("expected the join to succeed, got %v %v", resp, err)
+	}
+	key := heartbeatKey()
+	if len(key) == 0 {
+		t.Fatal("expected a heartbeat key to be generated for the cluster")
+	}
+	// Act as the joining node which keeps its own pulseha section
+	peerConfig := &config.Config{}
+	if err := json.Unmarshal(resp.Config, peerConfig); err != nil {
+		t.Fatal(err)
+	}
+	peerConfig.Pulse = config.Local{LocalNode: "b"}
+	if !bytes.Equal(peerConfig.GetHeartbeatKey(), key) {
+		t.Error("expected the joining node to sign health checks with the cluster heartbeat key")
+	}
+}
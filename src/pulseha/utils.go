@@ -19,53 +19,36 @@ package pulseha
 import (
 	"context"
 	"crypto/rand"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	log "github.com/sirupsen/logrus"
-	"github.com/syleron/pulseha/rpc"
+	"github.com/syleron/pulseha/packages/security"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
-	"net"
 	"runtime"
-	"time"
 )
 
-// MakeLocalActive brings up the assigned floating ip groups on the current node.
+// MakeLocalActive brings up the floating ip groups the current node should hold.
+// Note: The active holds every group that is not placed on another available node.
 func MakeLocalActive() {
 	log.Debug("Utils:MakeMemberActive() Local node now active")
-	localNode, err := DB.Config.GetLocalNode()
-	if err != nil {
-		if err != nil {
-			DB.Logging.Error("local node not found in config. Failed to make active.")
-			return
-		}
-	}
-	for _, node := range DB.Config.Nodes {
-		if node.Hostname == localNode.Hostname {
-			for iface, groups := range node.IPGroups {
-				for _, groupName := range groups {
-					makeGroupActive(iface, groupName)
-				}
-			}
-		}
+	if _, err := DB.Config.GetLocalNode(); err != nil {
+		DB.Logging.Error("local node not found in config. Failed to make active.")
+		return
 	}
+	reconcileLocalGroups(true)
 }
 
 // MakeLocalPassive brings down the assigned active floating ip groups on the current node.
+// Note: Groups placed on the current node are kept.
 func MakeLocalPassive() {
 	DB.Logging.Debug("Utils:MakeMemberPassive() Making local node passive")
-	localNode, err := DB.Config.GetLocalNode()
-	if err != nil {
+	if _, err := DB.Config.GetLocalNode(); err != nil {
 		DB.Logging.Error("local node not found in config. Failed to make passive.")
 		return
 	}
-	for _, node := range DB.Config.Nodes {
-		if node.Hostname == localNode.Hostname {
-			for iface, groups := range node.IPGroups {
-				for _, groupName := range groups {
-					makeGroupPassive(iface, groupName)
-				}
-			}
-		}
-	}
+	releaseLocalGroups(false)
 }
 
 // BringUpIPs brings up an array of ips on a particular network interface.
@@ -127,42 +110,75 @@ func MyCaller() string {
 	return fun.Name()
 }
 
-// GetFailOverCountWinner determines who is the correct active node if in a split-brain scenario.
-// TODO: IMPORTANT: Note: This only works with two nodes atm.
-func GetFailOverCountWinner(members []*rpc.MemberlistMember) string {
-	// GO through our members, are we failing back or not?
-	for i, member := range members {
-		if member.Status != rpc.MemberStatus_UNAVAILABLE {
-
-			tym, _ := time.Parse(time.RFC1123, member.LastReceived)
-			if tym == (time.Time{}) && DB.Config.Pulse.AutoFailback {
-				return member.Hostname
-			} else {
-				// If we are the second member, get the previous node
-				if i > 1 {
-					return members[i-1].Hostname
-				}
-				// Otherwise get the second node
-				return members[i+1].Hostname
-			}
+// peerCertificate returns the client certificate of a connection once it has been verified against the cluster CA.
+func peerCertificate(ctx context.Context) (*x509.Certificate, error) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("unable to get peer details for context")
+	}
+	tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo)
+	if !ok || tlsInfo.SecurityLevel != credentials.PrivacyAndIntegrity {
+		return nil, errors.New("connection is not encrypted")
+	}
+	if len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, errors.New("no client certificate signed by the cluster CA from " + pr.Addr.String())
+	}
+	// Connections made before a certificate was revoked stay open so check every request
+	if security.IsRevoked(tlsInfo.State.VerifiedChains[0][0]) {
+		return nil, errors.New("revoked client certificate from " + pr.Addr.String())
+	}
+	return tlsInfo.State.VerifiedChains[0][0], nil
+}
+
+// peerHostname returns the hostname of the member a connection is from using its certificate.
+func peerHostname(ctx context.Context) (string, error) {
+	cert, err := peerCertificate(ctx)
+	if err != nil {
+		return "", err
+	}
+	hostname, err := security.PeerIdentity(cert)
+	if err != nil {
+		return "", err
+	}
+	// Certificates issued by an external CA may hold more than one hostname.
+	// Note: Exactly one of them must be a member so a certificate can't act as several members.
+	var member string
+	for _, name := range cert.DNSNames {
+		if _, _, err := DB.Config.GetNodeByHostname(name); err != nil || name == member {
+			continue
+		}
+		if member != "" {
+			return "", errors.New("certificate issued to " + hostname + " names more than one member of the cluster")
 		}
+		member = name
 	}
-	return ""
+	if member == "" {
+		return "", errors.New("certificate issued to " + hostname + " which is not in the cluster")
+	}
+	return member, nil
 }
 
 // CanCommunicate used to determine if a connection is a member of our config.
+// Note: The member is identified by its certificate rather than its address.
 func CanCommunicate(ctx context.Context) bool {
-	pr, ok := peer.FromContext(ctx)
-	if !ok {
-		DB.Logging.Warn("Unable to get address details for context")
+	if _, err := peerHostname(ctx); err != nil {
+		DB.Logging.Warn(err.Error() + ". Communication received from another node not in cluster")
 		return false
 	}
-	// check to make sure the peer IP
-	_, err := DB.Config.GetNodeHostnameByAddress(pr.Addr.(*net.TCPAddr).IP.String())
+	return true
+}
+
+// CanCommunicateAs determines whether a connection is from the member a request claims to be from.
+func CanCommunicateAs(ctx context.Context, hostname string) bool {
+	peerHostname, err := peerHostname(ctx)
 	if err != nil {
 		DB.Logging.Warn(err.Error() + ". Communication received from another node not in cluster")
 		return false
 	}
+	if peerHostname != hostname {
+		DB.Logging.Warn(peerHostname + " attempted to send a request as " + hostname)
+		return false
+	}
 	return true
 }
 
@@ -1 +1,75 @@
 package pulseha
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"net"
+	"testing"
+)
+
+// peerContext creates the context of a request from a peer that presented a verified certificate issued to hostname.
+func peerContext(hostname string, verified bool) context.Context {
+	if !verified {
+		return certContext(nil)
+	}
+	return certContext(&x509.Certificate{DNSNames: []string{hostname}})
+}
+
+// certContext creates the context of a request from a peer that presented a verified certificate.
+func certContext(cert *x509.Certificate) context.Context {
+	state := tls.ConnectionState{}
+	if cert != nil {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.99"), Port: 1234},
+		AuthInfo: credentials.TLSInfo{
+			State:          state,
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		},
+	})
+}
+
+func TestCanCommunicateUsesCertIdentity(t *testing.T) {
+	setupTestPaths()
+	// The source address doesn't matter, only the certificate
+	if !CanCommunicate(peerContext("node2", true)) {
+		t.Error("expected a member with a verified certificate to be allowed")
+	}
+	if CanCommunicate(peerContext("node2", false)) {
+		t.Error("expected a connection without a verified certificate to be rejected")
+	}
+	if CanCommunicate(peerContext("node3", true)) {
+		t.Error("expected a certificate for a node outside the cluster to be rejected")
+	}
+	if CanCommunicate(context.Background()) {
+		t.Error("expected a request without peer details to be rejected")
+	}
+}
+
+func TestCanCommunicateAs(t *testing.T) {
+	setupTestPaths()
+	if !CanCommunicateAs(peerContext("node2", true), "node2") {
+		t.Error("expected a member to be allowed to send requests as itself")
+	}
+	if CanCommunicateAs(peerContext("node2", true), "node1") {
+		t.Error("expected a member to be rejected when claiming to be another member")
+	}
+}
+
+func TestCanCommunicateWithSeveralHostnames(t *testing.T) {
+	setupTestPaths()
+	// Certificates issued by an external CA may name the member's FQDN first
+	cert := &x509.Certificate{DNSNames: []string{"node2.example.com", "node2"}}
+	if !CanCommunicateAs(certContext(cert), "node2") {
+		t.Error("expected a member to be identified by any hostname in its certificate")
+	}
+	// A certificate naming several members can't be trusted as any of them
+	cert = &x509.Certificate{DNSNames: []string{"node2", "node1"}}
+	if CanCommunicate(certContext(cert)) || CanCommunicateAs(certContext(cert), "node2") {
+		t.Error("expected a certificate naming more than one member to be rejected")
+	}
+}